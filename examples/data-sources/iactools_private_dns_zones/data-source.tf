@@ -0,0 +1,16 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+data "iactools_private_dns_zones" "azure" {
+  cloud       = "azure"
+  environment = "public"
+  region      = "westeurope"
+}
+
+resource "azurerm_private_dns_zone" "hub" {
+  for_each = toset(data.iactools_private_dns_zones.azure.zone_names)
+
+  name                = each.value
+  resource_group_name = "rg-hub-dns"
+}
@@ -0,0 +1,38 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+
+locals {
+  account_id = "123456789012"
+  region     = "eu-west-1"
+}
+
+output "bucket_objects" {
+  value = provider::iactools::arn_build({
+    service     = "s3"
+    resource_id = "my-bucket"
+    path        = "logs/*"
+  })
+}
+
+output "role" {
+  value = provider::iactools::arn_build({
+    service       = "iam"
+    account_id    = local.account_id
+    resource_type = "role"
+    resource_id   = "deployer"
+    path          = "/service-role/ci/"
+  })
+}
+
+output "function" {
+  value = provider::iactools::arn_build({
+    service       = "lambda"
+    region        = local.region
+    account_id    = local.account_id
+    resource_type = "function"
+    resource_id   = "orders"
+    separator     = ":"
+  })
+}
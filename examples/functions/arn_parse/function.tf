@@ -0,0 +1,25 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+
+locals {
+  role   = provider::iactools::arn_parse("arn:aws:iam::123456789012:role/service-role/ci/deployer")
+  object = provider::iactools::arn_parse("arn:aws:s3:::my-bucket/logs/2026/app.log")
+}
+
+output "role" {
+  value = {
+    account_id = local.role.account_id
+    name       = local.role.resource_id
+    path       = local.role.path
+  }
+}
+
+output "bucket" {
+  value = local.object.resource_id
+}
+
+output "api_stage" {
+  value = provider::iactools::arn_parse("arn:aws:apigateway:eu-west-1::/restapis/a1b2c3/stages/prod").path
+}
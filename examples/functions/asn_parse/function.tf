@@ -0,0 +1,22 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+
+locals {
+  peers = {
+    transit  = "AS3356"
+    customer = "4200000001"
+    legacy   = "2.1"
+  }
+
+  asns = { for name, asn in local.peers : name => provider::iactools::asn_parse(asn) }
+}
+
+output "private_peers" {
+  value = [for name, asn in local.asns : name if asn.is_private]
+}
+
+output "peer_asns" {
+  value = { for name, asn in local.asns : name => asn.asn }
+}
@@ -0,0 +1,32 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+
+locals {
+  subscription_id = "00000000-0000-0000-0000-000000000000"
+}
+
+output "subnet_id" {
+  value = provider::iactools::azure_resource_id_build({
+    subscription_id     = local.subscription_id
+    resource_group_name = "rg-network"
+    resource_type       = "Microsoft.Network/virtualNetworks/subnets"
+    names               = ["vnet-hub", "snet-app"]
+  })
+}
+
+output "policy_assignment_id" {
+  value = provider::iactools::azure_resource_id_build({
+    management_group_name = "mg-platform"
+    resource_type         = "Microsoft.Authorization/policyAssignments"
+    names                 = ["deny-public-ip"]
+  })
+}
+
+output "resource_group_id" {
+  value = provider::iactools::azure_resource_id_build({
+    subscription_id     = local.subscription_id
+    resource_group_name = "rg-network"
+  })
+}
@@ -0,0 +1,21 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+
+locals {
+  subnet_id = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-network/providers/Microsoft.Network/virtualNetworks/vnet-hub/subnets/snet-app"
+  subnet    = provider::iactools::azure_resource_id_parse(local.subnet_id)
+}
+
+output "virtual_network" {
+  value = {
+    id                  = local.subnet.parent_id
+    name                = local.subnet.names[0]
+    resource_group_name = local.subnet.resource_group_name
+  }
+}
+
+output "role_assignment_scope_level" {
+  value = provider::iactools::azure_resource_id_parse("${local.subnet_id}/providers/Microsoft.Authorization/roleAssignments/00000000-0000-0000-0000-000000000001").scope_level
+}
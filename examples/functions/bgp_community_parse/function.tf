@@ -0,0 +1,19 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+
+locals {
+  communities = ["65001:100", "no-export", "rt:65001:4000000000", "soo:192.0.2.1:100", "4200000000:1:2"]
+}
+
+output "communities" {
+  value = { for community in local.communities : community => provider::iactools::bgp_community_parse(community) }
+}
+
+output "route_targets" {
+  value = [
+    for community in local.communities : provider::iactools::bgp_community_parse(community).value
+    if provider::iactools::bgp_community_parse(community).subtype == "rt"
+  ]
+}
@@ -0,0 +1,28 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+
+variable "route_map" {
+  type = list(object({
+    sequence        = number
+    action          = string
+    match_community = optional(list(string))
+    match_as_path   = optional(list(string))
+    match_prefix    = optional(list(string))
+  }))
+  default = [
+    { sequence = 10, action = "deny", match_prefix = ["10.0.0.0/8 le 32", "192.168.0.0/16 le 32"] },
+    { sequence = 20, action = "permit", match_community = ["65001:100"], match_as_path = ["^65001_"] },
+    { sequence = 30, action = "permit", match_prefix = ["0.0.0.0/0 le 24"] },
+  ]
+
+  validation {
+    condition     = provider::iactools::bgp_route_map_validate(var.route_map).valid
+    error_message = join("\n", provider::iactools::bgp_route_map_validate(var.route_map).problems)
+  }
+}
+
+output "route_map" {
+  value = var.route_map
+}
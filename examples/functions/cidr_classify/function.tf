@@ -0,0 +1,27 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+
+variable "subnets" {
+  type = map(string)
+  default = {
+    app  = "10.1.0.0/24"
+    data = "10.1.1.0/24"
+  }
+
+  validation {
+    condition = alltrue([
+      for cidr in values(var.subnets) : alltrue([
+        for entry in provider::iactools::cidr_classify(cidr) : entry.registry == "iana" && entry.name == "Private-Use"
+      ])
+    ])
+    error_message = "Subnets must only use private address space, without reserved or platform-internal ranges."
+  }
+}
+
+output "classification" {
+  value = { for cidr in ["172.17.0.0/24", "100.64.0.0/10", "10.96.0.0/16", "20.1.0.0/16"] : cidr => [
+    for entry in provider::iactools::cidr_classify(cidr) : "${entry.registry}: ${entry.name} (${entry.relation})"
+  ] }
+}
@@ -0,0 +1,17 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+
+locals {
+  special_purpose = provider::iactools::cidr_special_purpose("ipv4", null)
+  platform_ranges = provider::iactools::cidr_special_purpose("ipv4", true)
+}
+
+output "not_globally_reachable" {
+  value = [for entry in local.special_purpose : entry.cidr if entry.globally_reachable == false]
+}
+
+output "platform_ranges" {
+  value = { for entry in local.platform_ranges : "${entry.registry}: ${entry.name}" => entry.cidr... if entry.registry != "iana" }
+}
@@ -0,0 +1,31 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+
+locals {
+  address_space = "10.10.0.0/22"
+  subnets = {
+    gateway  = "10.10.0.0/27"
+    firewall = "10.10.0.64/26"
+    app      = "10.10.1.0/24"
+    data     = "10.10.3.0/25"
+  }
+
+  utilization = provider::iactools::cidr_utilization(local.address_space, values(local.subnets))
+}
+
+output "utilization" {
+  value = "${local.utilization.utilization}% of ${local.address_space} used, largest free block ${local.utilization.largest_free_block}"
+}
+
+output "free_blocks" {
+  value = local.utilization.free_blocks
+}
+
+check "address_space" {
+  assert {
+    condition     = local.utilization.utilization < 80
+    error_message = "The virtual network is ${local.utilization.utilization}% allocated, add address space."
+  }
+}
@@ -0,0 +1,25 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+
+locals {
+  # The published documents, vendored into the repository
+  service_tags = file("${path.module}/ServiceTags_Public.json")
+  aws_ranges   = file("${path.module}/ip-ranges.json")
+}
+
+output "azure_westeurope" {
+  value = provider::iactools::cloud_ip_ranges(local.service_tags, "azure", {
+    service   = "AzureCloud.westeurope"
+    family    = "ipv4"
+    summarize = true
+  })
+}
+
+output "aws_eu_west_1_ec2" {
+  value = provider::iactools::cloud_ip_ranges(local.aws_ranges, null, {
+    service = "EC2"
+    region  = ["eu-west-1", "eu-central-1"]
+  })
+}
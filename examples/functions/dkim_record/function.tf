@@ -0,0 +1,10 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+output "dkim_record" {
+  value = provider::iactools::dkim_record(file("${path.module}/dkim.pub"), {
+    selector = "s1"
+    domain   = "example.com"
+  })
+}
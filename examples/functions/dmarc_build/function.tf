@@ -0,0 +1,13 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+output "dmarc_build" {
+  value = provider::iactools::dmarc_build({
+    p     = "quarantine"
+    sp    = "reject"
+    pct   = 50
+    adkim = "s"
+    rua   = "mailto:dmarc-reports@example.com"
+  })
+}
@@ -0,0 +1,7 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+output "dmarc_parse" {
+  value = provider::iactools::dmarc_parse("v=DMARC1; p=reject; rua=mailto:dmarc-reports@example.com")
+}
@@ -0,0 +1,21 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+
+locals {
+  legacy_services = {
+    ldap = "10.20.0.10"
+    smtp = "10.20.0.25"
+  }
+
+  dns64 = { for name, ip in local.legacy_services : name => provider::iactools::dns64_synthesize(ip, "2001:db8:64::/96") }
+}
+
+output "aaaa_records" {
+  value = { for name, record in local.dns64 : name => record.aaaa }
+}
+
+output "ptr_records" {
+  value = { for name, record in local.dns64 : record.ptr_name => "${name}.example.com." }
+}
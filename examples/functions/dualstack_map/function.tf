@@ -0,0 +1,19 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+
+locals {
+  subnets = {
+    app  = "10.1.1.0/24"
+    data = "10.1.2.0/24"
+    web  = "10.1.10.0/24"
+  }
+
+  # 10.1.10.0/24 maps to 2001:db8:100:a::/64
+  ipv6_subnets = provider::iactools::dualstack_map(values(local.subnets), "2001:db8:100::/48", "embed")
+}
+
+output "subnets" {
+  value = { for name, cidr in local.subnets : name => [cidr, local.ipv6_subnets[cidr]] }
+}
@@ -0,0 +1,21 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+
+locals {
+  crypto_key = provider::iactools::gcp_resource_name_parse("projects/my-project/locations/europe-west1/keyRings/my-ring/cryptoKeys/my-key")
+  instance   = provider::iactools::gcp_resource_name_parse("https://www.googleapis.com/compute/v1/projects/my-project/zones/europe-west1-b/instances/web-1")
+}
+
+output "key_ring" {
+  value = local.crypto_key.parent
+}
+
+output "instance" {
+  value = {
+    project = local.instance.project
+    zone    = local.instance.location
+    name    = local.instance.name
+  }
+}
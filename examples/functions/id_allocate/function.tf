@@ -0,0 +1,36 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+variable "previous_vnis" {
+  description = "The VNIs allocated by the previous run, e.g. read from the outputs of the state"
+  type        = map(number)
+  default     = {}
+}
+
+locals {
+  tenants = {
+    contoso  = { vlans = 2 }
+    fabrikam = { vlans = 1 }
+    # Keeps its existing VLAN when tenants are added
+    northwind = { vlans = 1, vlan_id = 10 }
+  }
+
+  vlans = provider::iactools::id_allocate(
+    ["2-4094"],
+    { for name, tenant in local.tenants : name => { size = tenant.vlans, id = try(tenant.vlan_id, null) } },
+    ["1002-1005"],
+    null,
+  )
+
+  # Keeps the VNIs of the existing tenants when tenants are added or removed
+  vnis = provider::iactools::id_allocate(["10000-10999"], { for name, tenant in local.tenants : name => 1 }, null, var.previous_vnis)
+}
+
+output "vlans" {
+  value = { for name, vlan in local.vlans : name => range(vlan.id, vlan.last + 1) }
+}
+
+output "vnis" {
+  value = { for name, vni in local.vnis : name => vni.id }
+}
@@ -0,0 +1,12 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+# The first usable address after the gateway
+output "first_vm_ip" {
+  value = provider::iactools::ip_add("10.1.0.1", 3)
+}
+
+output "previous_ip" {
+  value = provider::iactools::ip_add("2001:db8::1:0", -1)
+}
@@ -0,0 +1,7 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+output "is_lower" {
+  value = provider::iactools::ip_compare("10.0.0.9", "10.0.0.10") < 0
+}
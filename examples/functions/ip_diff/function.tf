@@ -0,0 +1,8 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+# The number of addresses between the start and the end of a DHCP range
+output "dhcp_range_size" {
+  value = tonumber(provider::iactools::ip_diff("10.1.0.200", "10.1.0.100")) + 1
+}
@@ -0,0 +1,7 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+output "sorted" {
+  value = provider::iactools::ip_sort(["10.0.0.10", "10.0.0.9", "10.0.0.0/24", "2001:db8::1", "192.168.0.1"])
+}
@@ -0,0 +1,11 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+output "ipv4" {
+  value = provider::iactools::ip_to_int("10.0.0.1")
+}
+
+output "ipv6" {
+  value = provider::iactools::ip_to_int("2001:db8::1")
+}
@@ -0,0 +1,23 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+locals {
+  appliances = {
+    fw-01 = "00-0D-3A-12-34-56"
+    fw-02 = "00-0D-3A-12-34-57"
+  }
+
+  addresses = {
+    for name, mac in local.appliances : name => provider::iactools::ipv6_eui64("2001:db8:1:2::/64", mac)
+  }
+}
+
+output "addresses" {
+  value = local.addresses
+}
+
+# PTR record names to create before the appliances boot
+output "ptr_names" {
+  value = { for name, address in local.addresses : name => provider::iactools::reverse_dns(address) }
+}
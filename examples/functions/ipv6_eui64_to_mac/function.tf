@@ -0,0 +1,7 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+output "mac_address" {
+  value = provider::iactools::ipv6_eui64_to_mac("2001:db8:1:2:20d:3aff:fe12:3456")
+}
@@ -0,0 +1,7 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+output "link_local" {
+  value = provider::iactools::ipv6_link_local("00:0d:3a:12:34:56")
+}
@@ -0,0 +1,28 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+locals {
+  aks = provider::iactools::k8s_network_plan({
+    network_plugin = "overlay"
+    node_count     = 20
+    max_pods       = 250
+    parent_cidr    = "10.1.0.0/16"
+    reserved_cidrs = ["10.1.0.0/24"]
+    pod_cidr       = "192.168.0.0/16"
+    service_cidr   = "172.16.0.0/16"
+  })
+}
+
+output "node_subnet_cidr" {
+  value = local.aks.node_subnet_cidr
+}
+
+output "dns_service_ip" {
+  value = local.aks.dns_service_ip
+}
+
+# Pod ranges of the first nodes, as assigned by kubenet-style IPAM
+output "node_pod_cidrs" {
+  value = slice(local.aks.node_pod_cidrs, 0, 3)
+}
@@ -0,0 +1,16 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+output "colon" {
+  value = provider::iactools::mac_normalize("00-0D-3A-12-34-56", null)
+}
+
+output "cisco" {
+  value = provider::iactools::mac_normalize("00:0d:3a:12:34:56", "dot")
+}
+
+# Azure reports MAC addresses in upper case dash form
+output "azure" {
+  value = upper(provider::iactools::mac_normalize("000d.3a12.3456", "dash"))
+}
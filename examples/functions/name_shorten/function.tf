@@ -0,0 +1,12 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+
+output "short_name" {
+  value = provider::iactools::name_shorten("payments-processing-service-production", 24)
+}
+
+output "unchanged_name" {
+  value = provider::iactools::name_shorten("payments", 24)
+}
@@ -0,0 +1,18 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+
+locals {
+  nat64_prefix = "2001:db8:64::/96"
+}
+
+output "well_known" {
+  # 64:ff9b::101:101
+  value = provider::iactools::nat64_embed("1.1.1.1", null)
+}
+
+output "network_specific" {
+  # 2001:db8:64::a01:203
+  value = provider::iactools::nat64_embed("10.1.2.3", local.nat64_prefix)
+}
@@ -0,0 +1,9 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+
+output "ipv4_address" {
+  # 192.0.2.33
+  value = provider::iactools::nat64_extract("2001:db8:122:c000:2:2100::", 48)
+}
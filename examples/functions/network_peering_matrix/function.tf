@@ -0,0 +1,29 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+locals {
+  networks = {
+    hub-weu  = { role = "hub", region = "westeurope", gateway = true }
+    hub-neu  = { role = "hub", region = "northeurope", gateway = true }
+    app-weu  = { region = "westeurope" }
+    app-neu  = { region = "northeurope" }
+    identity = { role = "shared", region = "westeurope" }
+  }
+
+  peerings = provider::iactools::network_peering_matrix(local.networks, "multi_hub")
+}
+
+output "peering_pairs" {
+  value = local.peerings
+}
+
+# One peering per direction, keyed for use with for_each
+output "peerings" {
+  value = merge([
+    for pair in local.peerings : {
+      "${pair.local}-to-${pair.remote}" = merge(pair.local_to_remote, { network = pair.local, remote_network = pair.remote })
+      "${pair.remote}-to-${pair.local}" = merge(pair.remote_to_local, { network = pair.remote, remote_network = pair.local })
+    }
+  ]...)
+}
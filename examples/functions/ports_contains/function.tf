@@ -0,0 +1,7 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+output "https_allowed" {
+  value = provider::iactools::ports_contains("80,443,8000-8100", "443")
+}
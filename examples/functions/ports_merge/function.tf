@@ -0,0 +1,11 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+output "ports" {
+  value = provider::iactools::ports_merge([
+    "80,443",
+    "444-450",
+    "8080",
+  ], null)
+}
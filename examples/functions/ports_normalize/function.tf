@@ -0,0 +1,15 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+output "ports" {
+  value = provider::iactools::ports_normalize("443, 80, 8000-8100, 8050, 81", null)
+}
+
+output "azure_destination_port_ranges" {
+  value = provider::iactools::ports_normalize("443,80,8000-8100", "list")
+}
+
+output "port_range_objects" {
+  value = provider::iactools::ports_normalize("tcp/443,udp/53,tcp/80-81", "objects")
+}
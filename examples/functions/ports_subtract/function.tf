@@ -0,0 +1,12 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+# Every port except SSH and RDP
+output "ports" {
+  value = provider::iactools::ports_subtract("*", "22,3389", null)
+}
+
+output "aws_port_ranges" {
+  value = provider::iactools::ports_subtract("tcp/1024-65535", "tcp/5432,tcp/6379", "objects")
+}
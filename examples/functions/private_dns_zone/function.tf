@@ -0,0 +1,15 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+output "private_dns_zone_storage_blob" {
+  value = provider::iactools::private_dns_zone("azure", "public", "Microsoft.Storage/storageAccounts", "blob", null)
+}
+
+output "private_dns_zone_aks" {
+  value = provider::iactools::private_dns_zone("azure", "public", "Microsoft.ContainerService/managedClusters", "management", "westeurope")
+}
+
+output "private_dns_zone_aws_ssm" {
+  value = provider::iactools::private_dns_zone("aws", "commercial", "ssm", null, "eu-central-1")
+}
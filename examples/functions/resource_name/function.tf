@@ -0,0 +1,33 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+
+locals {
+  components = {
+    workload    = "payments-api"
+    environment = "prod"
+    region      = "weu"
+    instance    = "001"
+  }
+
+  names = {
+    for resource_type in ["azurerm_resource_group", "azurerm_key_vault", "azurerm_storage_account", "azurerm_linux_web_app"] :
+    resource_type => provider::iactools::resource_name(resource_type, local.components, null)
+  }
+}
+
+output "names" {
+  value = { for resource_type, name in local.names : resource_type => name.name }
+}
+
+output "globally_unique" {
+  value = [for resource_type, name in local.names : name.name if name.scope == "global"]
+}
+
+output "bucket" {
+  value = provider::iactools::resource_name("aws_s3_bucket", { workload = "logs", environment = "prod" }, {
+    order     = ["environment", "workload", "abbreviation"]
+    separator = "-"
+  }).name
+}
@@ -0,0 +1,22 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+
+variable "key_vault_name" {
+  type    = string
+  default = "kv-payments-prod-weu-001"
+
+  validation {
+    condition     = provider::iactools::resource_name_validate("azurerm_key_vault", var.key_vault_name).valid
+    error_message = "The key vault name is invalid: ${join("; ", provider::iactools::resource_name_validate("azurerm_key_vault", var.key_vault_name).problems)}."
+  }
+}
+
+output "key_vault_name" {
+  value = var.key_vault_name
+}
+
+output "bucket_problems" {
+  value = provider::iactools::resource_name_validate("aws_s3_bucket", "xn--Logs..Archive").problems
+}
@@ -0,0 +1,12 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+output "reverse_zone_plan" {
+  value = provider::iactools::reverse_zone_plan([
+    "10.20.0.0/21",
+    "10.20.32.0/26",
+    "10.20.32.192/26",
+    "2001:db8:abcd::/48",
+  ])
+}
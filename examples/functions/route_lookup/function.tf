@@ -0,0 +1,21 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+locals {
+  spoke_routes = [
+    { prefix = "10.1.0.0/16", next_hop_type = "VnetLocal", source = "system" },
+    { prefix = "0.0.0.0/0", next_hop_type = "Internet", source = "system" },
+    { prefix = "0.0.0.0/0", next_hop_type = "VirtualAppliance", next_hop = "10.0.0.4" },
+    { prefix = "192.168.0.0/16", next_hop_type = "VirtualNetworkGateway", source = "bgp" },
+  ]
+}
+
+# Internet traffic is forced through the firewall of the hub
+output "internet_route" {
+  value = provider::iactools::route_lookup(local.spoke_routes, "8.8.8.8", "azure")
+}
+
+output "on_premises_route" {
+  value = provider::iactools::route_lookup(local.spoke_routes, "192.168.10.0/24", "azure")
+}
@@ -0,0 +1,19 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+output "effective_routes" {
+  value = provider::iactools::route_table_effective(
+    [
+      { prefix = "10.1.0.0/16", next_hop_type = "VnetLocal" },
+      { prefix = "0.0.0.0/0", next_hop_type = "Internet" },
+    ],
+    [
+      { prefix = "0.0.0.0/0", next_hop_type = "VirtualAppliance", next_hop = "10.0.0.4" },
+    ],
+    [
+      { prefix = "192.168.0.0/16", next_hop_type = "VirtualNetworkGateway", next_hop = "10.0.0.68" },
+    ],
+    "azure"
+  )
+}
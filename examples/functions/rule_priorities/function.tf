@@ -0,0 +1,39 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+output "nsg_rule_priorities" {
+  value = provider::iactools::rule_priorities(
+    [
+      {
+        name  = "platform"
+        rules = ["allow-bastion", "allow-load-balancer"]
+      },
+      {
+        name  = "application"
+        rules = ["allow-http", "allow-https", { name = "deny-all-inbound", priority = 4096 }]
+      },
+    ],
+    {
+      platform = "azure_nsg"
+      spacing  = 10
+    }
+  )
+}
+
+# Keep the priorities of existing rules stable when a rule is added in the middle
+output "nsg_rule_priorities_with_existing" {
+  value = provider::iactools::rule_priorities(
+    [
+      {
+        rules = ["allow-bastion", "allow-monitoring", "allow-load-balancer"]
+      },
+    ],
+    {
+      existing = {
+        "allow-bastion"       = 100
+        "allow-load-balancer" = 110
+      }
+    }
+  )
+}
@@ -0,0 +1,47 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+locals {
+  nsg_rules = [
+    {
+      name                 = "allow-https"
+      priority             = 100
+      action               = "Allow"
+      protocol             = "Tcp"
+      source_prefixes      = "Internet"
+      destination_prefixes = "10.20.1.0/24"
+      destination_ports    = 443
+    },
+    {
+      name                 = "allow-web"
+      priority             = 110
+      action               = "Allow"
+      protocol             = "Tcp"
+      source_prefixes      = "10.0.0.0/8"
+      destination_prefixes = ["10.20.1.0/25", "10.20.1.128/25"]
+      destination_ports    = "80,443,8000-8100"
+    },
+    {
+      name                 = "deny-legacy"
+      priority             = 120
+      action               = "Deny"
+      protocol             = "Tcp"
+      source_prefixes      = "10.1.0.0/16"
+      destination_prefixes = "10.20.1.0/24"
+      destination_ports    = "8080"
+    },
+  ]
+}
+
+output "security_rule_findings" {
+  value = provider::iactools::security_rules_analyze(local.nsg_rules)
+}
+
+# Fail the plan when a rule never matches
+check "security_rules" {
+  assert {
+    condition     = length([for finding in provider::iactools::security_rules_analyze(local.nsg_rules) : finding if finding.type == "shadowed"]) == 0
+    error_message = "Some security rules are shadowed by rules of higher priority."
+  }
+}
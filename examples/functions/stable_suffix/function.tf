@@ -0,0 +1,16 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+
+locals {
+  suffix = provider::iactools::stable_suffix(["00000000-0000-0000-0000-000000000001", "prod", "payments"], 6, null)
+}
+
+output "storage_account_name" {
+  value = "stpaymentsprod${local.suffix}"
+}
+
+output "hex_suffix" {
+  value = provider::iactools::stable_suffix(["payments", "prod"], 8, "hex")
+}
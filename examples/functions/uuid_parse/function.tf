@@ -0,0 +1,12 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+
+output "version" {
+  value = provider::iactools::uuid_parse("017f22e2-79b0-7cc3-98c4-dc0c0c07398f").version
+}
+
+output "timestamp" {
+  value = provider::iactools::uuid_parse("017f22e2-79b0-7cc3-98c4-dc0c0c07398f").timestamp
+}
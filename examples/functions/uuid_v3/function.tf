@@ -0,0 +1,8 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+
+output "dns_uuid" {
+  value = provider::iactools::uuid_v3("dns", "www.example.com")
+}
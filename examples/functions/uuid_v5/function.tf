@@ -0,0 +1,16 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+
+locals {
+  subscription_id = "00000000-0000-0000-0000-000000000001"
+}
+
+output "role_assignment_name" {
+  value = provider::iactools::uuid_v5(local.subscription_id, "payments-readers-reader")
+}
+
+output "dns_uuid" {
+  value = provider::iactools::uuid_v5("dns", "www.example.com")
+}
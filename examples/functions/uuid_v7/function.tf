@@ -0,0 +1,8 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+
+output "event_id" {
+  value = provider::iactools::uuid_v7("2026-10-19T12:00:00Z", "orders")
+}
@@ -0,0 +1,239 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// arnPrefix is the first part of every ARN.
+const arnPrefix = "arn"
+
+// Services with quirks in the resource part of their ARNs.
+const (
+	arnServiceS3         = "s3"
+	arnServiceIAM        = "iam"
+	arnServiceAPIGateway = "apigateway"
+	arnServiceExecuteAPI = "execute-api"
+)
+
+// Default parts of built ARNs.
+const (
+	defaultARNPartition = "aws"
+	defaultARNSeparator = "/"
+)
+
+var (
+	// arnPartitionRegexp matches the partitions like aws, aws-cn and aws-us-gov.
+	arnPartitionRegexp = regexp.MustCompile(`^aws(-[a-z]+)*$`)
+
+	// arnServiceRegexp matches the service namespaces like s3 and execute-api.
+	arnServiceRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+	// arnRegionRegexp matches the regions like eu-west-1 and us-gov-west-1.
+	arnRegionRegexp = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-[0-9]+$`)
+
+	// arnAccountRegexp matches 12 digit account IDs and aws, the account of AWS managed resources.
+	arnAccountRegexp = regexp.MustCompile(`^([0-9]{12}|aws)$`)
+
+	// arnWildcardRegionRegexp matches the region patterns of IAM policies like * and eu-*.
+	arnWildcardRegionRegexp = regexp.MustCompile(`^[a-z0-9-]*[*?][a-z0-9*?-]*$`)
+
+	// arnWildcardAccountRegexp matches the account ID patterns of IAM policies like * and 1234*.
+	arnWildcardAccountRegexp = regexp.MustCompile(`^[0-9]*[*?][0-9*?]*$`)
+)
+
+// arnIAMPathTypes are the IAM resource types whose names can be preceded by a path.
+var arnIAMPathTypes = []string{"user", "role", "group", "policy", "instance-profile", "server-certificate"}
+
+// ARN holds the parts of an Amazon Resource Name.
+type ARN struct {
+	Partition    string  `tfsdk:"partition"`
+	Service      string  `tfsdk:"service"`
+	Region       *string `tfsdk:"region"`
+	AccountID    *string `tfsdk:"account_id"`
+	Resource     string  `tfsdk:"resource"`
+	ResourceType *string `tfsdk:"resource_type"`
+	ResourceID   string  `tfsdk:"resource_id"`
+	Path         *string `tfsdk:"path"`
+}
+
+// ARNParts holds the parts an ARN is built from.
+type ARNParts struct {
+	Partition    string
+	Service      string
+	Region       string
+	AccountID    string
+	Resource     string
+	ResourceType string
+	ResourceID   string
+	Separator    string
+	Path         string
+}
+
+// ParseARN splits an ARN into its partition, service, region, account and resource,
+// and the resource into its type, ID and path following the format of the service:
+//   - the resource type is separated from the ID by the first / or :, e.g. function:name for Lambda
+//   - S3 bucket and object ARNs have no type, the ID is the bucket and the path is the object key
+//   - IAM users, roles, groups, policies, instance profiles and server certificates have the IAM path between the type and the name
+//   - API Gateway resources are paths like /restapis/id/stages/name, the first two segments are the type and the ID
+//   - execute-api resources start with the API ID followed by the stage, method and resource path
+//
+// The region, the account ID and the resource can be patterns with * and ? wildcards, as in the resources of IAM policies.
+func ParseARN(value string) (ARN, error) {
+	parts := strings.SplitN(value, ":", 6)
+	if len(parts) < 6 || parts[0] != arnPrefix {
+		return ARN{}, fmt.Errorf("the ARN %q must have the format arn:partition:service:region:account-id:resource", value)
+	}
+	result := ARN{
+		Partition: parts[1],
+		Service:   parts[2],
+		Resource:  parts[5],
+	}
+	if !arnPartitionRegexp.MatchString(result.Partition) {
+		return ARN{}, fmt.Errorf("the ARN %q has the invalid partition %q", value, result.Partition)
+	}
+	if !arnServiceRegexp.MatchString(result.Service) {
+		return ARN{}, fmt.Errorf("the ARN %q has the invalid service %q", value, result.Service)
+	}
+	if parts[3] != "" {
+		if !arnRegionRegexp.MatchString(parts[3]) && !arnWildcardRegionRegexp.MatchString(parts[3]) {
+			return ARN{}, fmt.Errorf("the ARN %q has the invalid region %q", value, parts[3])
+		}
+		result.Region = &parts[3]
+	}
+	if parts[4] != "" {
+		if !arnAccountRegexp.MatchString(parts[4]) && !arnWildcardAccountRegexp.MatchString(parts[4]) {
+			return ARN{}, fmt.Errorf("the ARN %q has the invalid account ID %q, it must be 12 digits", value, parts[4])
+		}
+		result.AccountID = &parts[4]
+	}
+	if result.Resource == "" {
+		return ARN{}, fmt.Errorf("the ARN %q has no resource", value)
+	}
+
+	resource := result.Resource
+	switch {
+	case result.Service == arnServiceS3 && result.AccountID == nil:
+		// Buckets and objects: bucket/key
+		bucket, key, found := strings.Cut(resource, "/")
+		result.ResourceID = bucket
+		if found {
+			result.Path = &key
+		}
+	case result.Service == arnServiceAPIGateway && strings.HasPrefix(resource, "/"):
+		// Paths: /restapis/id/stages/name
+		segments := strings.SplitN(resource[1:], "/", 3)
+		if len(segments) == 1 {
+			result.ResourceID = segments[0]
+			break
+		}
+		result.ResourceType, result.ResourceID = &segments[0], segments[1]
+		if len(segments) == 3 {
+			path := "/" + segments[2]
+			result.Path = &path
+		}
+	case result.Service == arnServiceExecuteAPI:
+		// API ID followed by the stage, method and resource path: id/stage/GET/path
+		id, path, found := strings.Cut(resource, "/")
+		result.ResourceID = id
+		if found {
+			result.Path = &path
+		}
+	default:
+		index := strings.IndexAny(resource, "/:")
+		if index < 0 {
+			result.ResourceID = resource
+			break
+		}
+		resourceType, id := resource[:index], resource[index+1:]
+		result.ResourceType, result.ResourceID = &resourceType, id
+		if result.Service == arnServiceIAM && resource[index] == '/' && slices.Contains(arnIAMPathTypes, resourceType) {
+			// IAM paths: role/path/to/name
+			path := "/"
+			if last := strings.LastIndex(id, "/"); last >= 0 {
+				path, result.ResourceID = "/"+id[:last+1], id[last+1:]
+			}
+			result.Path = &path
+		}
+	}
+	if result.ResourceID == "" {
+		return ARN{}, fmt.Errorf("the ARN %q has no resource ID", value)
+	}
+	return result, nil
+}
+
+// BuildARN joins the parts of an ARN, the resource is either given as is or built from the type, ID and path following the format of the service.
+// The built ARN is parsed to validate it.
+func BuildARN(parts ARNParts) (string, error) {
+	if parts.Partition == "" {
+		parts.Partition = defaultARNPartition
+	}
+	if parts.Service == "" {
+		return "", fmt.Errorf("service must be provided")
+	}
+
+	resource := parts.Resource
+	if resource != "" {
+		if parts.ResourceType != "" || parts.ResourceID != "" || parts.Path != "" {
+			return "", fmt.Errorf("resource cannot be combined with resource_type, resource_id or path")
+		}
+	} else {
+		if parts.ResourceID == "" {
+			return "", fmt.Errorf("either resource or resource_id must be provided")
+		}
+		separator := parts.Separator
+		if separator == "" {
+			separator = defaultARNSeparator
+		}
+		if separator != "/" && separator != ":" {
+			return "", fmt.Errorf("separator must be / or :")
+		}
+
+		switch {
+		case parts.Service == arnServiceS3 && parts.ResourceType == "":
+			resource = parts.ResourceID
+			if parts.Path != "" {
+				resource += "/" + strings.TrimPrefix(parts.Path, "/")
+			}
+		case parts.Service == arnServiceAPIGateway:
+			resource = "/" + parts.ResourceID
+			if parts.ResourceType != "" {
+				resource = "/" + parts.ResourceType + resource
+			}
+			if parts.Path != "" {
+				resource += "/" + strings.TrimPrefix(parts.Path, "/")
+			}
+		case parts.Service == arnServiceExecuteAPI:
+			resource = parts.ResourceID
+			if parts.Path != "" {
+				resource += "/" + strings.TrimPrefix(parts.Path, "/")
+			}
+		case parts.Service == arnServiceIAM && slices.Contains(arnIAMPathTypes, parts.ResourceType):
+			path := "/" + strings.Trim(parts.Path, "/") + "/"
+			if path == "//" {
+				path = "/"
+			}
+			resource = parts.ResourceType + path + parts.ResourceID
+		default:
+			if parts.Path != "" {
+				return "", fmt.Errorf("path is only supported for S3, IAM, API Gateway and execute-api resources")
+			}
+			resource = parts.ResourceID
+			if parts.ResourceType != "" {
+				resource = parts.ResourceType + separator + resource
+			}
+		}
+	}
+
+	arn := strings.Join([]string{arnPrefix, parts.Partition, parts.Service, parts.Region, parts.AccountID, resource}, ":")
+	if _, err := ParseARN(arn); err != nil {
+		return "", err
+	}
+	return arn, nil
+}
@@ -0,0 +1,119 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = ARNBuildFunction{}
+)
+
+// NewARNBuildFunction is a helper function to create a new instance of ARNBuildFunction.
+func NewARNBuildFunction() function.Function {
+	return ARNBuildFunction{}
+}
+
+// ARNBuildFunction is the struct for the ARN build function.
+type ARNBuildFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f ARNBuildFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "arn_build"
+}
+
+// Definition sets the definition for the function.
+func (f ARNBuildFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build an AWS ARN",
+		MarkdownDescription: "Joins the parts of an Amazon Resource Name, the inverse of `arn_parse`. " +
+			"The `partition` defaults to `aws`, and `region` and `account_id` are left empty when omitted, as in the ARNs of global resources like IAM roles or S3 buckets. " +
+			"The resource is either the whole `resource`, or is built from the optional `resource_type`, the `resource_id` and the `path` " +
+			"following the same service formats as `arn_parse`: the object key of S3 objects, the IAM path of IAM resources " +
+			"and the rest of the path of API Gateway and execute-api resources. " +
+			"The `separator` between the resource type and ID is `/` by default, set it to `:` for services like Lambda or CloudWatch Logs. " +
+			"The built ARN is validated like in `arn_parse`, so the `region`, `account_id` and resource can be patterns with `*` and `?` wildcards for the resources of IAM policies.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name: "parts",
+				MarkdownDescription: "An object with the attributes `service` and `resource` or `resource_id`, and the optional attributes " +
+					"`partition`, `region`, `account_id`, `resource_type`, `separator` and `path`",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run executes the ARN build function.
+func (f ARNBuildFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var partsArgument types.Dynamic
+
+	// Parse the argument
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &partsArgument))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input argument
+	parts, err := parseARNParts(partsArgument)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error parsing parts: %s", err.Error())))
+		return
+	}
+
+	// Build the ARN
+	arn, err := BuildARN(parts)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error building ARN: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.StringValue(arn)))
+}
+
+// parseARNParts converts the parts argument into ARN parts.
+func parseARNParts(value types.Dynamic) (ARNParts, error) {
+	var parts ARNParts
+	converted, err := dynamicToGo(value)
+	if err != nil {
+		return parts, err
+	}
+	object, err := goObject(converted, "parts")
+	if err != nil {
+		return parts, err
+	}
+	if err := checkObjectKeys(object, "parts", "partition", "service", "region", "account_id", "resource", "resource_type", "resource_id", "separator", "path"); err != nil {
+		return parts, err
+	}
+
+	for _, attribute := range []struct {
+		key    string
+		target *string
+	}{
+		{"partition", &parts.Partition},
+		{"service", &parts.Service},
+		{"region", &parts.Region},
+		{"account_id", &parts.AccountID},
+		{"resource", &parts.Resource},
+		{"resource_type", &parts.ResourceType},
+		{"resource_id", &parts.ResourceID},
+		{"separator", &parts.Separator},
+		{"path", &parts.Path},
+	} {
+		if object[attribute.key] != nil {
+			if *attribute.target, err = goString(object[attribute.key], "parts."+attribute.key); err != nil {
+				return parts, err
+			}
+		}
+	}
+	return parts, nil
+}
@@ -0,0 +1,150 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestARNBuildFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		result    string
+	}{
+		"resource": {
+			arguments: `{ service = "sns", region = "eu-west-1", account_id = "123456789012", resource = "alerts" }`,
+			result:    `"arn:aws:sns:eu-west-1:123456789012:alerts"`,
+		},
+		"type-and-id": {
+			arguments: `{ service = "ec2", region = "eu-west-1", account_id = "123456789012", resource_type = "instance", resource_id = "i-0abc123" }`,
+			result:    `"arn:aws:ec2:eu-west-1:123456789012:instance/i-0abc123"`,
+		},
+		"colon-separator": {
+			arguments: `{ service = "lambda", region = "eu-west-1", account_id = "123456789012", resource_type = "function", resource_id = "orders", separator = ":" }`,
+			result:    `"arn:aws:lambda:eu-west-1:123456789012:function:orders"`,
+		},
+		"partition": {
+			arguments: `{ partition = "aws-cn", service = "ec2", region = "cn-north-1", account_id = "123456789012", resource_type = "vpc", resource_id = "vpc-0abc" }`,
+			result:    `"arn:aws-cn:ec2:cn-north-1:123456789012:vpc/vpc-0abc"`,
+		},
+		"s3-bucket": {
+			arguments: `{ service = "s3", resource_id = "my-bucket" }`,
+			result:    `"arn:aws:s3:::my-bucket"`,
+		},
+		"s3-object": {
+			arguments: `{ service = "s3", resource_id = "my-bucket", path = "logs/*" }`,
+			result:    `"arn:aws:s3:::my-bucket/logs/*"`,
+		},
+		"iam-role-path": {
+			arguments: `{ service = "iam", account_id = "123456789012", resource_type = "role", resource_id = "deployer", path = "/service-role/ci/" }`,
+			result:    `"arn:aws:iam::123456789012:role/service-role/ci/deployer"`,
+		},
+		"iam-role": {
+			arguments: `{ service = "iam", account_id = "123456789012", resource_type = "role", resource_id = "deployer" }`,
+			result:    `"arn:aws:iam::123456789012:role/deployer"`,
+		},
+		"api-gateway": {
+			arguments: `{ service = "apigateway", region = "eu-west-1", resource_type = "restapis", resource_id = "a1b2c3", path = "/stages/prod" }`,
+			result:    `"arn:aws:apigateway:eu-west-1::/restapis/a1b2c3/stages/prod"`,
+		},
+		"execute-api": {
+			arguments: `{ service = "execute-api", region = "eu-west-1", account_id = "123456789012", resource_id = "a1b2c3", path = "*/GET/pets" }`,
+			result:    `"arn:aws:execute-api:eu-west-1:123456789012:a1b2c3/*/GET/pets"`,
+		},
+		"wildcard": {
+			arguments: `{ service = "logs", region = "*", account_id = "*", resource_type = "log-group", resource_id = "*", separator = ":" }`,
+			result:    `"arn:aws:logs:*:*:log-group:*"`,
+		},
+		"wildcard-resource": {
+			arguments: `{ service = "sqs", region = "eu-*", account_id = "123456789012", resource = "orders-*" }`,
+			result:    `"arn:aws:sqs:eu-*:123456789012:orders-*"`,
+		},
+		"round-trip": {
+			arguments: `{ for key, value in provider::iactools::arn_parse("arn:aws:iam::123456789012:role/service-role/ci/deployer") : key => value if value != null && key != "resource" }`,
+			result:    `"arn:aws:iam::123456789012:role/service-role/ci/deployer"`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::arn_build(%s))
+							}
+						`, testCase.arguments),
+						Check: resource.TestCheckOutput("result", testCase.result),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestARNBuildFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		error     string
+	}{
+		"no-service": {
+			arguments: `{ resource = "x" }`,
+			error:     `(?s)Call to function "provider::iactools::arn_build" failed.*Error\s+building\s+ARN:\s+service\s+must\s+be\s+provided`,
+		},
+		"resource-and-id": {
+			arguments: `{ service = "sns", resource = "x", resource_id = "y" }`,
+			error:     `(?s)Call to function "provider::iactools::arn_build" failed.*Error\s+building\s+ARN:\s+resource\s+cannot\s+be\s+combined\s+with\s+resource_type,\s+resource_id\s+or\s+path`,
+		},
+		"no-resource": {
+			arguments: `{ service = "sns" }`,
+			error:     `(?s)Call to function "provider::iactools::arn_build" failed.*Error\s+building\s+ARN:\s+either\s+resource\s+or\s+resource_id\s+must\s+be\s+provided`,
+		},
+		"separator": {
+			arguments: `{ service = "ec2", resource_type = "vpc", resource_id = "v", separator = "." }`,
+			error:     `(?s)Call to function "provider::iactools::arn_build" failed.*Error\s+building\s+ARN:\s+separator\s+must\s+be\s+/\s+or\s+:`,
+		},
+		"path-unsupported": {
+			arguments: `{ service = "ec2", resource_type = "vpc", resource_id = "v", path = "x" }`,
+			error:     `(?s)Call to function "provider::iactools::arn_build" failed.*Error\s+building\s+ARN:\s+path\s+is\s+only\s+supported\s+for\s+S3,\s+IAM,\s+API\s+Gateway\s+and\s+execute-api\s+resources`,
+		},
+		"invalid-region": {
+			arguments: `{ service = "ec2", region = "europe", resource_type = "vpc", resource_id = "v" }`,
+			error:     `(?s)Call to function "provider::iactools::arn_build" failed.*Error\s+building\s+ARN:\s+the\s+ARN\s+"arn:aws:ec2:europe::vpc/v"\s+has\s+the\s+invalid\s+region\s+"europe"`,
+		},
+		"unsupported-attribute": {
+			arguments: `{ service = "sns", name = "x" }`,
+			error:     `(?s)Call to function "provider::iactools::arn_build" failed.*Error\s+parsing\s+parts:\s+parts\s+has\s+unsupported\s+attributes:\s+\[name\]`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::arn_build(%s)
+							}
+						`, testCase.arguments),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = ARNParseFunction{}
+)
+
+// NewARNParseFunction is a helper function to create a new instance of ARNParseFunction.
+func NewARNParseFunction() function.Function {
+	return ARNParseFunction{}
+}
+
+// ARNParseFunction is the struct for the ARN parse function.
+type ARNParseFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f ARNParseFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "arn_parse"
+}
+
+// Definition sets the definition for the function.
+func (f ARNParseFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse an AWS ARN",
+		MarkdownDescription: "Splits an Amazon Resource Name into the `partition`, `service`, `region`, `account_id` and `resource`, " +
+			"where `region` and `account_id` are null for global resources, and the resource into the `resource_type`, `resource_id` and `path`. " +
+			"The resource type is separated from the ID by the first `/` or `:`, e.g. `instance/i-0abc` for EC2 or `function:name:alias` for Lambda, " +
+			"and is null when the resource has no type, e.g. an SNS topic. Services with their own resource formats are handled as follows: " +
+			"S3 bucket and object ARNs have the bucket as `resource_id` and the object key as `path`; " +
+			"IAM users, roles, groups, policies, instance profiles and server certificates have the name as `resource_id` and the IAM path, e.g. `/service-role/`, as `path`; " +
+			"API Gateway resources like `/restapis/id/stages/prod` have the first two segments as `resource_type` and `resource_id` and the rest as `path`; " +
+			"and execute-api resources have the API ID as `resource_id` and the stage, method and resource path as `path`. " +
+			"The `region`, `account_id` and resource can be patterns with `*` and `?` wildcards as in the resources of IAM policies, e.g. `arn:aws:logs:*:*:log-group:*`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "arn",
+				MarkdownDescription: "The ARN to parse",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"partition":     types.StringType,
+				"service":       types.StringType,
+				"region":        types.StringType,
+				"account_id":    types.StringType,
+				"resource":      types.StringType,
+				"resource_type": types.StringType,
+				"resource_id":   types.StringType,
+				"path":          types.StringType,
+			},
+		},
+	}
+}
+
+// Run executes the ARN parse function.
+func (f ARNParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arn string
+
+	// Parse the argument
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &arn))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input argument
+	if arn == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The arn argument must be provided and valid"))
+		return
+	}
+
+	// Parse the ARN
+	parsed, err := ParseARN(arn)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error parsing ARN: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, parsed))
+}
@@ -0,0 +1,186 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestARNParseFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		result    string
+	}{
+		"ec2-instance": {
+			arguments: `"arn:aws:ec2:eu-west-1:123456789012:instance/i-0abc123"`,
+			result:    `{"account_id":"123456789012","partition":"aws","path":null,"region":"eu-west-1","resource":"instance/i-0abc123","resource_id":"i-0abc123","resource_type":"instance","service":"ec2"}`,
+		},
+		"lambda-alias": {
+			arguments: `"arn:aws:lambda:eu-west-1:123456789012:function:orders:live"`,
+			result:    `{"account_id":"123456789012","partition":"aws","path":null,"region":"eu-west-1","resource":"function:orders:live","resource_id":"orders:live","resource_type":"function","service":"lambda"}`,
+		},
+		"sns-topic": {
+			arguments: `"arn:aws:sns:eu-west-1:123456789012:alerts"`,
+			result:    `{"account_id":"123456789012","partition":"aws","path":null,"region":"eu-west-1","resource":"alerts","resource_id":"alerts","resource_type":null,"service":"sns"}`,
+		},
+		"govcloud": {
+			arguments: `"arn:aws-us-gov:ec2:us-gov-west-1:123456789012:vpc/vpc-0abc"`,
+			result:    `{"account_id":"123456789012","partition":"aws-us-gov","path":null,"region":"us-gov-west-1","resource":"vpc/vpc-0abc","resource_id":"vpc-0abc","resource_type":"vpc","service":"ec2"}`,
+		},
+		"s3-bucket": {
+			arguments: `"arn:aws:s3:::my-bucket"`,
+			result:    `{"account_id":null,"partition":"aws","path":null,"region":null,"resource":"my-bucket","resource_id":"my-bucket","resource_type":null,"service":"s3"}`,
+		},
+		"s3-object": {
+			arguments: `"arn:aws:s3:::my-bucket/logs/2026/app.log"`,
+			result:    `{"account_id":null,"partition":"aws","path":"logs/2026/app.log","region":null,"resource":"my-bucket/logs/2026/app.log","resource_id":"my-bucket","resource_type":null,"service":"s3"}`,
+		},
+		"s3-access-point": {
+			arguments: `"arn:aws:s3:eu-west-1:123456789012:accesspoint/reports"`,
+			result:    `{"account_id":"123456789012","partition":"aws","path":null,"region":"eu-west-1","resource":"accesspoint/reports","resource_id":"reports","resource_type":"accesspoint","service":"s3"}`,
+		},
+		"iam-role-path": {
+			arguments: `"arn:aws:iam::123456789012:role/service-role/ci/deployer"`,
+			result:    `{"account_id":"123456789012","partition":"aws","path":"/service-role/ci/","region":null,"resource":"role/service-role/ci/deployer","resource_id":"deployer","resource_type":"role","service":"iam"}`,
+		},
+		"iam-managed-policy": {
+			arguments: `"arn:aws:iam::aws:policy/ReadOnlyAccess"`,
+			result:    `{"account_id":"aws","partition":"aws","path":"/","region":null,"resource":"policy/ReadOnlyAccess","resource_id":"ReadOnlyAccess","resource_type":"policy","service":"iam"}`,
+		},
+		"iam-root": {
+			arguments: `"arn:aws:iam::123456789012:root"`,
+			result:    `{"account_id":"123456789012","partition":"aws","path":null,"region":null,"resource":"root","resource_id":"root","resource_type":null,"service":"iam"}`,
+		},
+		"iam-oidc-provider": {
+			arguments: `"arn:aws:iam::123456789012:oidc-provider/token.actions.githubusercontent.com"`,
+			result:    `{"account_id":"123456789012","partition":"aws","path":null,"region":null,"resource":"oidc-provider/token.actions.githubusercontent.com","resource_id":"token.actions.githubusercontent.com","resource_type":"oidc-provider","service":"iam"}`,
+		},
+		"api-gateway-stage": {
+			arguments: `"arn:aws:apigateway:eu-west-1::/restapis/a1b2c3/stages/prod"`,
+			result:    `{"account_id":null,"partition":"aws","path":"/stages/prod","region":"eu-west-1","resource":"/restapis/a1b2c3/stages/prod","resource_id":"a1b2c3","resource_type":"restapis","service":"apigateway"}`,
+		},
+		"api-gateway-account": {
+			arguments: `"arn:aws:apigateway:eu-west-1::/account"`,
+			result:    `{"account_id":null,"partition":"aws","path":null,"region":"eu-west-1","resource":"/account","resource_id":"account","resource_type":null,"service":"apigateway"}`,
+		},
+		"execute-api": {
+			arguments: `"arn:aws:execute-api:eu-west-1:123456789012:a1b2c3/prod/GET/pets"`,
+			result:    `{"account_id":"123456789012","partition":"aws","path":"prod/GET/pets","region":"eu-west-1","resource":"a1b2c3/prod/GET/pets","resource_id":"a1b2c3","resource_type":null,"service":"execute-api"}`,
+		},
+		"wildcard-region-and-account": {
+			arguments: `"arn:aws:logs:*:*:log-group:*"`,
+			result:    `{"account_id":"*","partition":"aws","path":null,"region":"*","resource":"log-group:*","resource_id":"*","resource_type":"log-group","service":"logs"}`,
+		},
+		"wildcard-region-pattern": {
+			arguments: `"arn:aws:ec2:eu-*:123456789012:instance/*"`,
+			result:    `{"account_id":"123456789012","partition":"aws","path":null,"region":"eu-*","resource":"instance/*","resource_id":"*","resource_type":"instance","service":"ec2"}`,
+		},
+		"wildcard-resource": {
+			arguments: `"arn:aws:dynamodb:eu-west-1:123456789012:table/orders-*"`,
+			result:    `{"account_id":"123456789012","partition":"aws","path":null,"region":"eu-west-1","resource":"table/orders-*","resource_id":"orders-*","resource_type":"table","service":"dynamodb"}`,
+		},
+		"log-group": {
+			arguments: `"arn:aws:logs:eu-west-1:123456789012:log-group:/aws/lambda/orders:*"`,
+			result:    `{"account_id":"123456789012","partition":"aws","path":null,"region":"eu-west-1","resource":"log-group:/aws/lambda/orders:*","resource_id":"/aws/lambda/orders:*","resource_type":"log-group","service":"logs"}`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::arn_parse(%s))
+							}
+						`, testCase.arguments),
+						Check: resource.TestCheckOutput("result", testCase.result),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestARNParseFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		error     string
+	}{
+		"format": {
+			arguments: `"arn:aws:s3"`,
+			error:     `(?s)Call to function "provider::iactools::arn_parse" failed.*Error\s+parsing\s+ARN:\s+the\s+ARN\s+"arn:aws:s3"\s+must\s+have\s+the\s+format\s+arn:partition:service:region:account-id:resource`,
+		},
+		"prefix": {
+			arguments: `"urn:aws:s3:::b"`,
+			error:     `(?s)Call to function "provider::iactools::arn_parse" failed.*Error\s+parsing\s+ARN:\s+the\s+ARN\s+"urn:aws:s3:::b"\s+must\s+have\s+the\s+format\s+arn:partition:service:region:account-id:resource`,
+		},
+		"partition": {
+			arguments: `"arn:azure:s3:::b"`,
+			error:     `(?s)Call to function "provider::iactools::arn_parse" failed.*Error\s+parsing\s+ARN:\s+the\s+ARN\s+"arn:azure:s3:::b"\s+has\s+the\s+invalid\s+partition\s+"azure"`,
+		},
+		"service": {
+			arguments: `"arn:aws:S3:::b"`,
+			error:     `(?s)Call to function "provider::iactools::arn_parse" failed.*Error\s+parsing\s+ARN:\s+the\s+ARN\s+"arn:aws:S3:::b"\s+has\s+the\s+invalid\s+service\s+"S3"`,
+		},
+		"region": {
+			arguments: `"arn:aws:ec2:europe:123456789012:vpc/v"`,
+			error:     `(?s)Call to function "provider::iactools::arn_parse" failed.*Error\s+parsing\s+ARN:\s+the\s+ARN\s+"arn:aws:ec2:europe:123456789012:vpc/v"\s+has\s+the\s+invalid\s+region\s+"europe"`,
+		},
+		"account": {
+			arguments: `"arn:aws:ec2:eu-west-1:1234:vpc/v"`,
+			error:     `(?s)Call to function "provider::iactools::arn_parse" failed.*Error\s+parsing\s+ARN:\s+the\s+ARN\s+"arn:aws:ec2:eu-west-1:1234:vpc/v"\s+has\s+the\s+invalid\s+account\s+ID\s+"1234",\s+it\s+must\s+be\s+12\s+digits`,
+		},
+		"wildcard-region": {
+			arguments: `"arn:aws:ec2:EU-*:123456789012:vpc/v"`,
+			error:     `(?s)Call to function "provider::iactools::arn_parse" failed.*Error\s+parsing\s+ARN:\s+the\s+ARN\s+"arn:aws:ec2:EU-\*:123456789012:vpc/v"\s+has\s+the\s+invalid\s+region\s+"EU-\*"`,
+		},
+		"wildcard-account": {
+			arguments: `"arn:aws:ec2:eu-west-1:acct-*:vpc/v"`,
+			error:     `(?s)Call to function "provider::iactools::arn_parse" failed.*Error\s+parsing\s+ARN:\s+the\s+ARN\s+"arn:aws:ec2:eu-west-1:acct-\*:vpc/v"\s+has\s+the\s+invalid\s+account\s+ID\s+"acct-\*"`,
+		},
+		"no-resource": {
+			arguments: `"arn:aws:sns:eu-west-1:123456789012:"`,
+			error:     `(?s)Call to function "provider::iactools::arn_parse" failed.*Error\s+parsing\s+ARN:\s+the\s+ARN\s+"arn:aws:sns:eu-west-1:123456789012:"\s+has\s+no\s+resource`,
+		},
+		"no-resource-id": {
+			arguments: `"arn:aws:iam::123456789012:role/"`,
+			error:     `(?s)Call to function "provider::iactools::arn_parse" failed.*Error\s+parsing\s+ARN:\s+the\s+ARN\s+"arn:aws:iam::123456789012:role/"\s+has\s+no\s+resource\s+ID`,
+		},
+		"empty": {
+			arguments: `""`,
+			error:     `(?s)Call to function "provider::iactools::arn_parse" failed.*The\s+arn\s+argument\s+must\s+be\s+provided\s+and\s+valid`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::arn_parse(%s)
+							}
+						`, testCase.arguments),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = ASNParseFunction{}
+)
+
+// NewASNParseFunction is a helper function to create a new instance of ASNParseFunction.
+func NewASNParseFunction() function.Function {
+	return ASNParseFunction{}
+}
+
+// ASNParseFunction is the struct for the ASN parse function.
+type ASNParseFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f ASNParseFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "asn_parse"
+}
+
+// Definition sets the definition for the function.
+func (f ASNParseFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse and classify a BGP AS number",
+		MarkdownDescription: "Accepts an AS number in asplain (`65001`, `4200000000`) or asdot (`64086.59904`) notation, optionally prefixed with `AS`, " +
+			"and outputs the `asn` as a number, its `asdot` notation, and whether it is a private (RFC 6996), reserved or 4-byte AS number. " +
+			"Reserved AS numbers are 0, 23456 (AS_TRANS), 64496-64511 and 65536-65551 (documentation), 65535, 65552-131071 and 4294967295.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "value",
+				MarkdownDescription: "The AS number",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"asn":         types.Int64Type,
+				"asdot":       types.StringType,
+				"is_private":  types.BoolType,
+				"is_reserved": types.BoolType,
+				"is_4byte":    types.BoolType,
+			},
+		},
+	}
+}
+
+// Run executes the ASN parse function.
+func (f ASNParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+
+	// Parse the argument
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &value))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input argument
+	if value == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The value argument must be provided and valid"))
+		return
+	}
+
+	// Parse the AS number
+	asn, err := ASNParse(value)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error parsing AS number: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, asn))
+}
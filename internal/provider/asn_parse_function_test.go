@@ -0,0 +1,114 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestASNParseFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		result    string
+	}{
+		"private-2byte": {
+			arguments: `"65001"`,
+			result:    `{"asdot":"65001","asn":65001,"is_4byte":false,"is_private":true,"is_reserved":false}`,
+		},
+		"public-with-prefix": {
+			arguments: `"AS13335"`,
+			result:    `{"asdot":"13335","asn":13335,"is_4byte":false,"is_private":false,"is_reserved":false}`,
+		},
+		"number": {
+			arguments: `8075`,
+			result:    `{"asdot":"8075","asn":8075,"is_4byte":false,"is_private":false,"is_reserved":false}`,
+		},
+		"private-4byte": {
+			arguments: `"4200000000"`,
+			result:    `{"asdot":"64086.59904","asn":4200000000,"is_4byte":true,"is_private":true,"is_reserved":false}`,
+		},
+		"asdot": {
+			arguments: `"1.10"`,
+			result:    `{"asdot":"1.10","asn":65546,"is_4byte":true,"is_private":false,"is_reserved":true}`,
+		},
+		"as-trans": {
+			arguments: `"23456"`,
+			result:    `{"asdot":"23456","asn":23456,"is_4byte":false,"is_private":false,"is_reserved":true}`,
+		},
+		"public-4byte": {
+			arguments: `"AS 131072"`,
+			result:    `{"asdot":"2.0","asn":131072,"is_4byte":true,"is_private":false,"is_reserved":false}`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::asn_parse(%s))
+							}
+						`, testCase.arguments),
+						Check: resource.TestCheckOutput("result", testCase.result),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestASNParseFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		error     string
+	}{
+		"empty": {
+			arguments: `""`,
+			error:     `(?s)Call to function "provider::iactools::asn_parse" failed.*The\s+value\s+argument\s+must\s+be\s+provided\s+and\s+valid`,
+		},
+		"too-large": {
+			arguments: `"4294967296"`,
+			error:     `(?s)Call to function "provider::iactools::asn_parse" failed.*Error\s+parsing\s+AS\s+number:\s+invalid\s+AS\s+number\s+"4294967296":\s+must\s+be\s+between\s+0\s+and\s+4294967295`,
+		},
+		"not-a-number": {
+			arguments: `"ASX"`,
+			error:     `(?s)Call to function "provider::iactools::asn_parse" failed.*Error\s+parsing\s+AS\s+number:\s+invalid\s+AS\s+number\s+"ASX"`,
+		},
+		"asdot-out-of-range": {
+			arguments: `"1.65536"`,
+			error:     `(?s)Call to function "provider::iactools::asn_parse" failed.*invalid\s+AS\s+number\s+"1.65536":\s+asdot\s+notation\s+must\s+be\s+two\s+numbers\s+between\s+0\s+and\s+65535`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::asn_parse(%s)
+							}
+						`, testCase.arguments),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
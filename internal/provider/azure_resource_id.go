@@ -0,0 +1,269 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Segments of Azure resource IDs, matched regardless of case and output in this case.
+const (
+	azureIDSubscriptions       = "subscriptions"
+	azureIDResourceGroups      = "resourceGroups"
+	azureIDProviders           = "providers"
+	azureIDManagementNamespace = "Microsoft.Management"
+	azureIDManagementGroups    = "managementGroups"
+	azureIDResourcesNamespace  = "Microsoft.Resources"
+)
+
+// Scope levels of Azure resources.
+const (
+	azureScopeTenant          = "tenant"
+	azureScopeManagementGroup = "management_group"
+	azureScopeSubscription    = "subscription"
+	azureScopeResourceGroup   = "resource_group"
+	azureScopeResource        = "resource"
+)
+
+// AzureResourceID holds the parts of an Azure resource ID.
+type AzureResourceID struct {
+	ID                  string   `tfsdk:"id"`
+	SubscriptionID      *string  `tfsdk:"subscription_id"`
+	ResourceGroupName   *string  `tfsdk:"resource_group_name"`
+	ManagementGroupName *string  `tfsdk:"management_group_name"`
+	ProviderNamespace   string   `tfsdk:"provider_namespace"`
+	ResourceType        string   `tfsdk:"resource_type"`
+	Types               []string `tfsdk:"types"`
+	Names               []string `tfsdk:"names"`
+	Name                string   `tfsdk:"name"`
+	ParentID            string   `tfsdk:"parent_id"`
+	Scope               string   `tfsdk:"scope"`
+	ScopeLevel          string   `tfsdk:"scope_level"`
+	IsExtension         bool     `tfsdk:"is_extension"`
+}
+
+// AzureResourceIDParts holds the parts an Azure resource ID is built from.
+type AzureResourceIDParts struct {
+	Scope               string
+	SubscriptionID      string
+	ResourceGroupName   string
+	ManagementGroupName string
+	ResourceType        string
+	Names               []string
+}
+
+// ParseAzureResourceID splits an Azure resource ID into its scope, provider namespace, type chain and name chain.
+// Resources of other providers under a resource, like role assignments or diagnostic settings, are extension resources scoped to that resource.
+func ParseAzureResourceID(id string) (AzureResourceID, error) {
+	if !strings.HasPrefix(id, "/") {
+		return AzureResourceID{}, fmt.Errorf("the ID %q must start with /", id)
+	}
+	path := strings.TrimSuffix(id[1:], "/")
+	if path == "" {
+		return AzureResourceID{}, fmt.Errorf("the ID %q has no segments", id)
+	}
+	segments := strings.Split(path, "/")
+	for _, segment := range segments {
+		if segment == "" {
+			return AzureResourceID{}, fmt.Errorf("the ID %q contains an empty segment", id)
+		}
+	}
+
+	result := AzureResourceID{
+		Scope:      "/",
+		ScopeLevel: azureScopeTenant,
+		ParentID:   "/",
+	}
+	scope := ""
+	position := 0
+
+	// Read the subscription and resource group or the management group of the scope
+	switch {
+	case strings.EqualFold(segments[0], azureIDSubscriptions):
+		if len(segments) < 2 {
+			return AzureResourceID{}, fmt.Errorf("the ID %q has no subscription ID after the %s segment", id, azureIDSubscriptions)
+		}
+		result.SubscriptionID = &segments[1]
+		scope = "/" + azureIDSubscriptions + "/" + segments[1]
+		position = 2
+		result.ProviderNamespace = azureIDResourcesNamespace
+		result.Types, result.Names = []string{azureIDSubscriptions}, []string{segments[1]}
+		if len(segments) > 2 && strings.EqualFold(segments[2], azureIDResourceGroups) {
+			if len(segments) < 4 {
+				return AzureResourceID{}, fmt.Errorf("the ID %q has no resource group name after the %s segment", id, azureIDResourceGroups)
+			}
+			result.ResourceGroupName = &segments[3]
+			result.Scope, result.ScopeLevel, result.ParentID = scope, azureScopeSubscription, scope
+			scope += "/" + azureIDResourceGroups + "/" + segments[3]
+			position = 4
+			result.Types, result.Names = []string{azureIDResourceGroups}, []string{segments[3]}
+		}
+	case len(segments) >= 3 && strings.EqualFold(segments[0], azureIDProviders) &&
+		strings.EqualFold(segments[1], azureIDManagementNamespace) && strings.EqualFold(segments[2], azureIDManagementGroups):
+		if len(segments) < 4 {
+			return AzureResourceID{}, fmt.Errorf("the ID %q has no management group name after the %s segment", id, azureIDManagementGroups)
+		}
+		result.ManagementGroupName = &segments[3]
+		scope = "/" + strings.Join([]string{azureIDProviders, azureIDManagementNamespace, azureIDManagementGroups, segments[3]}, "/")
+		position = 4
+		result.ProviderNamespace = azureIDManagementNamespace
+		result.Types, result.Names = []string{azureIDManagementGroups}, []string{segments[3]}
+	}
+	if position == len(segments) {
+		result.ResourceType = result.ProviderNamespace + "/" + result.Types[0]
+		result.Name = result.Names[0]
+		result.ID = scope
+		return result, nil
+	}
+
+	// Read the provider blocks, every block after the first one is an extension of the resource before it
+	scopeLevel := azureScopeTenant
+	switch {
+	case result.ResourceGroupName != nil:
+		scopeLevel = azureScopeResourceGroup
+	case result.SubscriptionID != nil:
+		scopeLevel = azureScopeSubscription
+	case result.ManagementGroupName != nil:
+		scopeLevel = azureScopeManagementGroup
+	}
+	resourceID := scope
+	for block := 0; position < len(segments); block++ {
+		if !strings.EqualFold(segments[position], azureIDProviders) {
+			return AzureResourceID{}, fmt.Errorf("the ID %q has the segment %q where %s is expected", id, segments[position], azureIDProviders)
+		}
+		if position+1 == len(segments) {
+			return AzureResourceID{}, fmt.Errorf("the ID %q has no provider namespace after the %s segment", id, azureIDProviders)
+		}
+		if block > 0 {
+			scope, scopeLevel = resourceID, azureScopeResource
+		}
+		namespace := segments[position+1]
+		position += 2
+
+		var types, names []string
+		for position < len(segments) && !strings.EqualFold(segments[position], azureIDProviders) {
+			if position+1 == len(segments) {
+				return AzureResourceID{}, fmt.Errorf("the ID %q has no name after the resource type %q", id, segments[position])
+			}
+			types = append(types, segments[position])
+			names = append(names, segments[position+1])
+			position += 2
+		}
+		if len(types) == 0 {
+			return AzureResourceID{}, fmt.Errorf("the ID %q has no resource type after the provider namespace %q", id, namespace)
+		}
+
+		// The parent of a child resource is the resource before the last type, the parent of a top-level resource is its scope
+		resourceID = scope + "/" + azureIDProviders + "/" + namespace
+		result.ParentID = scopeOrRoot(scope)
+		for i := range types {
+			if i > 0 && i == len(types)-1 {
+				result.ParentID = resourceID
+			}
+			resourceID += "/" + types[i] + "/" + names[i]
+		}
+		result.ProviderNamespace, result.Types, result.Names = namespace, types, names
+	}
+
+	result.ID = resourceID
+	result.ResourceType = result.ProviderNamespace + "/" + strings.Join(result.Types, "/")
+	result.Name = result.Names[len(result.Names)-1]
+	result.Scope = scopeOrRoot(scope)
+	result.ScopeLevel = scopeLevel
+	result.IsExtension = scopeLevel == azureScopeResource
+	return result, nil
+}
+
+// BuildAzureResourceID joins the scope, resource type and names into an Azure resource ID.
+// Without a resource type the ID of the scope is built.
+func BuildAzureResourceID(parts AzureResourceIDParts) (string, error) {
+	scope := ""
+	switch {
+	case parts.Scope != "":
+		if parts.SubscriptionID != "" || parts.ResourceGroupName != "" || parts.ManagementGroupName != "" {
+			return "", fmt.Errorf("scope cannot be combined with subscription_id, resource_group_name or management_group_name")
+		}
+		if parts.Scope != "/" {
+			parsed, err := ParseAzureResourceID(parts.Scope)
+			if err != nil {
+				return "", fmt.Errorf("invalid scope: %v", err)
+			}
+			scope = parsed.ID
+		}
+	case parts.ManagementGroupName != "":
+		if parts.SubscriptionID != "" || parts.ResourceGroupName != "" {
+			return "", fmt.Errorf("management_group_name cannot be combined with subscription_id or resource_group_name")
+		}
+		if err := validateAzureIDSegment(parts.ManagementGroupName, "management_group_name"); err != nil {
+			return "", err
+		}
+		scope = "/" + strings.Join([]string{azureIDProviders, azureIDManagementNamespace, azureIDManagementGroups, parts.ManagementGroupName}, "/")
+	case parts.SubscriptionID != "":
+		if err := validateAzureIDSegment(parts.SubscriptionID, "subscription_id"); err != nil {
+			return "", err
+		}
+		scope = "/" + azureIDSubscriptions + "/" + parts.SubscriptionID
+		if parts.ResourceGroupName != "" {
+			if err := validateAzureIDSegment(parts.ResourceGroupName, "resource_group_name"); err != nil {
+				return "", err
+			}
+			scope += "/" + azureIDResourceGroups + "/" + parts.ResourceGroupName
+		}
+	case parts.ResourceGroupName != "":
+		return "", fmt.Errorf("resource_group_name requires subscription_id")
+	}
+
+	if parts.ResourceType == "" {
+		if len(parts.Names) > 0 {
+			return "", fmt.Errorf("names requires resource_type")
+		}
+		if scope == "" {
+			return "", fmt.Errorf("either resource_type or a scope must be provided")
+		}
+		return scope, nil
+	}
+
+	// Pair the types of the resource type with the names
+	types := strings.Split(parts.ResourceType, "/")
+	namespace := types[0]
+	types = types[1:]
+	if !strings.Contains(namespace, ".") || len(types) == 0 {
+		return "", fmt.Errorf("the resource type %q must be a provider namespace followed by types, e.g. Microsoft.Network/virtualNetworks", parts.ResourceType)
+	}
+	if len(parts.Names) != len(types) {
+		return "", fmt.Errorf("the resource type %q needs %d names, got %d", parts.ResourceType, len(types), len(parts.Names))
+	}
+	id := scope + "/" + azureIDProviders + "/" + namespace
+	for i, resourceType := range types {
+		if err := validateAzureIDSegment(resourceType, "resource_type"); err != nil {
+			return "", err
+		}
+		if err := validateAzureIDSegment(parts.Names[i], fmt.Sprintf("names[%d]", i)); err != nil {
+			return "", err
+		}
+		id += "/" + resourceType + "/" + parts.Names[i]
+	}
+	return id, nil
+}
+
+// validateAzureIDSegment checks that a value can be used as a single segment of an Azure resource ID.
+func validateAzureIDSegment(value, path string) error {
+	if value == "" {
+		return fmt.Errorf("%s must not contain empty segments", path)
+	}
+	if strings.Contains(value, "/") {
+		return fmt.Errorf("%s %q must not contain /", path, value)
+	}
+	return nil
+}
+
+// scopeOrRoot returns the scope ID, or / for the tenant scope.
+func scopeOrRoot(scope string) string {
+	if scope == "" {
+		return "/"
+	}
+	return scope
+}
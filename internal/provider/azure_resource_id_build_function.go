@@ -0,0 +1,119 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = AzureResourceIDBuildFunction{}
+)
+
+// NewAzureResourceIDBuildFunction is a helper function to create a new instance of AzureResourceIDBuildFunction.
+func NewAzureResourceIDBuildFunction() function.Function {
+	return AzureResourceIDBuildFunction{}
+}
+
+// AzureResourceIDBuildFunction is the struct for the Azure resource ID build function.
+type AzureResourceIDBuildFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f AzureResourceIDBuildFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "azure_resource_id_build"
+}
+
+// Definition sets the definition for the function.
+func (f AzureResourceIDBuildFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build an Azure resource ID",
+		MarkdownDescription: "Joins the parts of an Azure resource ID, the inverse of `azure_resource_id_parse`. " +
+			"The scope is either the `scope` ID, e.g. the `scope` output of `azure_resource_id_parse` or the ID of the resource an extension resource is created under, " +
+			"or the `subscription_id` with an optional `resource_group_name`, or the `management_group_name`; no scope is the tenant. " +
+			"The `resource_type` is the provider namespace followed by the type chain, e.g. `Microsoft.Network/virtualNetworks/subnets`, " +
+			"and `names` is the list of names with one name per type, e.g. the virtual network and the subnet name. " +
+			"Without `resource_type` and `names` the ID of the scope is output, e.g. the ID of a resource group.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name: "parts",
+				MarkdownDescription: "An object with the optional attributes `scope`, `subscription_id`, `resource_group_name`, `management_group_name`, " +
+					"`resource_type` and `names`",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run executes the Azure resource ID build function.
+func (f AzureResourceIDBuildFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var partsArgument types.Dynamic
+
+	// Parse the argument
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &partsArgument))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input argument
+	parts, err := parseAzureResourceIDParts(partsArgument)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error parsing parts: %s", err.Error())))
+		return
+	}
+
+	// Build the resource ID
+	id, err := BuildAzureResourceID(parts)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error building Azure resource ID: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.StringValue(id)))
+}
+
+// parseAzureResourceIDParts converts the parts argument into Azure resource ID parts.
+func parseAzureResourceIDParts(value types.Dynamic) (AzureResourceIDParts, error) {
+	var parts AzureResourceIDParts
+	converted, err := dynamicToGo(value)
+	if err != nil {
+		return parts, err
+	}
+	object, err := goObject(converted, "parts")
+	if err != nil {
+		return parts, err
+	}
+	if err := checkObjectKeys(object, "parts", "scope", "subscription_id", "resource_group_name", "management_group_name", "resource_type", "names"); err != nil {
+		return parts, err
+	}
+
+	for _, attribute := range []struct {
+		key    string
+		target *string
+	}{
+		{"scope", &parts.Scope},
+		{"subscription_id", &parts.SubscriptionID},
+		{"resource_group_name", &parts.ResourceGroupName},
+		{"management_group_name", &parts.ManagementGroupName},
+		{"resource_type", &parts.ResourceType},
+	} {
+		if object[attribute.key] != nil {
+			if *attribute.target, err = goString(object[attribute.key], "parts."+attribute.key); err != nil {
+				return parts, err
+			}
+		}
+	}
+	if object["names"] != nil {
+		if parts.Names, err = goStringList(object["names"], "parts.names"); err != nil {
+			return parts, err
+		}
+	}
+	return parts, nil
+}
@@ -0,0 +1,154 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAzureResourceIDBuildFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		result    string
+	}{
+		"subnet": {
+			arguments: `{ subscription_id = "00000000-0000-0000-0000-000000000000", resource_group_name = "rg-network", resource_type = "Microsoft.Network/virtualNetworks/subnets", names = ["vnet-hub", "snet-app"] }`,
+			result:    `"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-network/providers/Microsoft.Network/virtualNetworks/vnet-hub/subnets/snet-app"`,
+		},
+		"resource-group": {
+			arguments: `{ subscription_id = "00000000-0000-0000-0000-000000000000", resource_group_name = "rg-network" }`,
+			result:    `"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-network"`,
+		},
+		"subscription-resource": {
+			arguments: `{ subscription_id = "00000000-0000-0000-0000-000000000000", resource_type = "Microsoft.Authorization/roleDefinitions", names = "reader" }`,
+			result:    `"/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/roleDefinitions/reader"`,
+		},
+		"management-group": {
+			arguments: `{ management_group_name = "mg-platform", resource_type = "Microsoft.Authorization/policyAssignments", names = ["deny-public-ip"] }`,
+			result:    `"/providers/Microsoft.Management/managementGroups/mg-platform/providers/Microsoft.Authorization/policyAssignments/deny-public-ip"`,
+		},
+		"management-group-id": {
+			arguments: `{ management_group_name = "mg-platform" }`,
+			result:    `"/providers/Microsoft.Management/managementGroups/mg-platform"`,
+		},
+		"tenant": {
+			arguments: `{ resource_type = "Microsoft.Authorization/policyDefinitions", names = ["allowed-locations"] }`,
+			result:    `"/providers/Microsoft.Authorization/policyDefinitions/allowed-locations"`,
+		},
+		"extension": {
+			arguments: `{ scope = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-network/providers/Microsoft.Network/virtualNetworks/vnet-hub", resource_type = "Microsoft.Insights/diagnosticSettings", names = ["diag"] }`,
+			result:    `"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-network/providers/Microsoft.Network/virtualNetworks/vnet-hub/providers/Microsoft.Insights/diagnosticSettings/diag"`,
+		},
+		"normalized-scope": {
+			arguments: `{ scope = "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/rg-network", resource_type = "Microsoft.Network/virtualNetworks", names = ["vnet-hub"] }`,
+			result:    `"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-network/providers/Microsoft.Network/virtualNetworks/vnet-hub"`,
+		},
+		"round-trip": {
+			arguments: `merge({ for key, value in provider::iactools::azure_resource_id_parse("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-network/providers/Microsoft.Network/virtualNetworks/vnet-hub/subnets/snet-app") : key => value if contains(["scope", "resource_type", "names"], key) }, { names = ["vnet-hub", "snet-db"] })`,
+			result:    `"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-network/providers/Microsoft.Network/virtualNetworks/vnet-hub/subnets/snet-db"`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::azure_resource_id_build(%s))
+							}
+						`, testCase.arguments),
+						Check: resource.TestCheckOutput("result", testCase.result),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAzureResourceIDBuildFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		error     string
+	}{
+		"scope-and-subscription": {
+			arguments: `{ scope = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-network", subscription_id = "x" }`,
+			error:     `(?s)Call to function "provider::iactools::azure_resource_id_build" failed.*Error\s+building\s+Azure\s+resource\s+ID:\s+scope\s+cannot\s+be\s+combined\s+with\s+subscription_id,\s+resource_group_name\s+or\s+management_group_name`,
+		},
+		"invalid-scope": {
+			arguments: `{ scope = "rg-network" }`,
+			error:     `(?s)Call to function "provider::iactools::azure_resource_id_build" failed.*Error\s+building\s+Azure\s+resource\s+ID:\s+invalid\s+scope:\s+the\s+ID\s+"rg-network"\s+must\s+start\s+with\s+/`,
+		},
+		"management-group-and-subscription": {
+			arguments: `{ management_group_name = "mg", subscription_id = "x" }`,
+			error:     `(?s)Call to function "provider::iactools::azure_resource_id_build" failed.*Error\s+building\s+Azure\s+resource\s+ID:\s+management_group_name\s+cannot\s+be\s+combined\s+with\s+subscription_id\s+or\s+resource_group_name`,
+		},
+		"resource-group-without-subscription": {
+			arguments: `{ resource_group_name = "rg" }`,
+			error:     `(?s)Call to function "provider::iactools::azure_resource_id_build" failed.*Error\s+building\s+Azure\s+resource\s+ID:\s+resource_group_name\s+requires\s+subscription_id`,
+		},
+		"names-without-type": {
+			arguments: `{ subscription_id = "x", names = ["a"] }`,
+			error:     `(?s)Call to function "provider::iactools::azure_resource_id_build" failed.*Error\s+building\s+Azure\s+resource\s+ID:\s+names\s+requires\s+resource_type`,
+		},
+		"nothing": {
+			arguments: `{}`,
+			error:     `(?s)Call to function "provider::iactools::azure_resource_id_build" failed.*Error\s+building\s+Azure\s+resource\s+ID:\s+either\s+resource_type\s+or\s+a\s+scope\s+must\s+be\s+provided`,
+		},
+		"no-namespace": {
+			arguments: `{ subscription_id = "x", resource_type = "virtualNetworks", names = ["a"] }`,
+			error:     `(?s)Call to function "provider::iactools::azure_resource_id_build" failed.*Error\s+building\s+Azure\s+resource\s+ID:\s+the\s+resource\s+type\s+"virtualNetworks"\s+must\s+be\s+a\s+provider\s+namespace\s+followed\s+by\s+types,\s+e.g.\s+Microsoft.Network/virtualNetworks`,
+		},
+		"name-count": {
+			arguments: `{ subscription_id = "x", resource_type = "Microsoft.Network/virtualNetworks/subnets", names = ["a"] }`,
+			error:     `(?s)Call to function "provider::iactools::azure_resource_id_build" failed.*Error\s+building\s+Azure\s+resource\s+ID:\s+the\s+resource\s+type\s+"Microsoft.Network/virtualNetworks/subnets"\s+needs\s+2\s+names,\s+got\s+1`,
+		},
+		"slash-in-name": {
+			arguments: `{ subscription_id = "x", resource_type = "Microsoft.Network/virtualNetworks", names = ["a/b"] }`,
+			error:     `(?s)Call to function "provider::iactools::azure_resource_id_build" failed.*Error\s+building\s+Azure\s+resource\s+ID:\s+names\[0\]\s+"a/b"\s+must\s+not\s+contain\s+/`,
+		},
+		"empty-name": {
+			arguments: `{ subscription_id = "x", resource_type = "Microsoft.Network/virtualNetworks", names = [""] }`,
+			error:     `(?s)Call to function "provider::iactools::azure_resource_id_build" failed.*Error\s+building\s+Azure\s+resource\s+ID:\s+names\[0\]\s+must\s+not\s+contain\s+empty\s+segments`,
+		},
+		"unsupported-attribute": {
+			arguments: `{ subscription = "x" }`,
+			error:     `(?s)Call to function "provider::iactools::azure_resource_id_build" failed.*Error\s+parsing\s+parts:\s+parts\s+has\s+unsupported\s+attributes:\s+\[subscription\]`,
+		},
+		"not-an-object": {
+			arguments: `"x"`,
+			error:     `(?s)Call to function "provider::iactools::azure_resource_id_build" failed.*Error\s+parsing\s+parts:\s+parts\s+must\s+be\s+an\s+object`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::azure_resource_id_build(%s)
+							}
+						`, testCase.arguments),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
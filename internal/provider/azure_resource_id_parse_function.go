@@ -0,0 +1,96 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = AzureResourceIDParseFunction{}
+)
+
+// NewAzureResourceIDParseFunction is a helper function to create a new instance of AzureResourceIDParseFunction.
+func NewAzureResourceIDParseFunction() function.Function {
+	return AzureResourceIDParseFunction{}
+}
+
+// AzureResourceIDParseFunction is the struct for the Azure resource ID parse function.
+type AzureResourceIDParseFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f AzureResourceIDParseFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "azure_resource_id_parse"
+}
+
+// Definition sets the definition for the function.
+func (f AzureResourceIDParseFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse an Azure resource ID",
+		MarkdownDescription: "Splits an Azure resource ID into the `subscription_id`, `resource_group_name` and `management_group_name` of its scope, " +
+			"the `provider_namespace`, the `types` and `names` chains of the resource and its parents, the full `resource_type`, e.g. `Microsoft.Network/virtualNetworks/subnets`, and the `name`. " +
+			"The `parent_id` is the ID of the parent resource of a child resource, or the scope of a top-level resource. " +
+			"The `scope` is the ID the resource is deployed at, `/` for the tenant, and the `scope_level` is `tenant`, `management_group`, `subscription`, `resource_group` or `resource`. " +
+			"Resources of a provider under another resource, like role assignments or diagnostic settings, are extension resources with the `resource` scope level and `is_extension` set. " +
+			"Subscription, resource group and management group IDs are parsed as `Microsoft.Resources/subscriptions`, `Microsoft.Resources/resourceGroups` and `Microsoft.Management/managementGroups` resources. " +
+			"The `subscriptions`, `resourceGroups`, `providers` and `managementGroups` segments are matched regardless of case, and the `id` output has them in their canonical case.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "id",
+				MarkdownDescription: "The Azure resource ID to parse",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"id":                    types.StringType,
+				"subscription_id":       types.StringType,
+				"resource_group_name":   types.StringType,
+				"management_group_name": types.StringType,
+				"provider_namespace":    types.StringType,
+				"resource_type":         types.StringType,
+				"types":                 types.ListType{ElemType: types.StringType},
+				"names":                 types.ListType{ElemType: types.StringType},
+				"name":                  types.StringType,
+				"parent_id":             types.StringType,
+				"scope":                 types.StringType,
+				"scope_level":           types.StringType,
+				"is_extension":          types.BoolType,
+			},
+		},
+	}
+}
+
+// Run executes the Azure resource ID parse function.
+func (f AzureResourceIDParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+
+	// Parse the argument
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &id))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input argument
+	if id == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The id argument must be provided and valid"))
+		return
+	}
+
+	// Parse the resource ID
+	parsed, err := ParseAzureResourceID(id)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error parsing Azure resource ID: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, parsed))
+}
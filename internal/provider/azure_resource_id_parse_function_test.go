@@ -0,0 +1,146 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAzureResourceIDParseFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		result    string
+	}{
+		"subnet": {
+			arguments: `"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-network/providers/Microsoft.Network/virtualNetworks/vnet-hub/subnets/snet-app"`,
+			result:    `{"id":"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-network/providers/Microsoft.Network/virtualNetworks/vnet-hub/subnets/snet-app","is_extension":false,"management_group_name":null,"name":"snet-app","names":["vnet-hub","snet-app"],"parent_id":"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-network/providers/Microsoft.Network/virtualNetworks/vnet-hub","provider_namespace":"Microsoft.Network","resource_group_name":"rg-network","resource_type":"Microsoft.Network/virtualNetworks/subnets","scope":"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-network","scope_level":"resource_group","subscription_id":"00000000-0000-0000-0000-000000000000","types":["virtualNetworks","subnets"]}`,
+		},
+		"case-insensitive": {
+			arguments: `"/SUBSCRIPTIONS/00000000-0000-0000-0000-000000000000/resourcegroups/rg-network/Providers/Microsoft.Network/virtualNetworks/vnet-hub/"`,
+			result:    `{"id":"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-network/providers/Microsoft.Network/virtualNetworks/vnet-hub","is_extension":false,"management_group_name":null,"name":"vnet-hub","names":["vnet-hub"],"parent_id":"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-network","provider_namespace":"Microsoft.Network","resource_group_name":"rg-network","resource_type":"Microsoft.Network/virtualNetworks","scope":"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-network","scope_level":"resource_group","subscription_id":"00000000-0000-0000-0000-000000000000","types":["virtualNetworks"]}`,
+		},
+		"subscription": {
+			arguments: `"/subscriptions/00000000-0000-0000-0000-000000000000"`,
+			result:    `{"id":"/subscriptions/00000000-0000-0000-0000-000000000000","is_extension":false,"management_group_name":null,"name":"00000000-0000-0000-0000-000000000000","names":["00000000-0000-0000-0000-000000000000"],"parent_id":"/","provider_namespace":"Microsoft.Resources","resource_group_name":null,"resource_type":"Microsoft.Resources/subscriptions","scope":"/","scope_level":"tenant","subscription_id":"00000000-0000-0000-0000-000000000000","types":["subscriptions"]}`,
+		},
+		"resource-group": {
+			arguments: `"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-network"`,
+			result:    `{"id":"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-network","is_extension":false,"management_group_name":null,"name":"rg-network","names":["rg-network"],"parent_id":"/subscriptions/00000000-0000-0000-0000-000000000000","provider_namespace":"Microsoft.Resources","resource_group_name":"rg-network","resource_type":"Microsoft.Resources/resourceGroups","scope":"/subscriptions/00000000-0000-0000-0000-000000000000","scope_level":"subscription","subscription_id":"00000000-0000-0000-0000-000000000000","types":["resourceGroups"]}`,
+		},
+		"subscription-resource": {
+			arguments: `"/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/roleDefinitions/reader"`,
+			result:    `{"id":"/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/roleDefinitions/reader","is_extension":false,"management_group_name":null,"name":"reader","names":["reader"],"parent_id":"/subscriptions/00000000-0000-0000-0000-000000000000","provider_namespace":"Microsoft.Authorization","resource_group_name":null,"resource_type":"Microsoft.Authorization/roleDefinitions","scope":"/subscriptions/00000000-0000-0000-0000-000000000000","scope_level":"subscription","subscription_id":"00000000-0000-0000-0000-000000000000","types":["roleDefinitions"]}`,
+		},
+		"management-group": {
+			arguments: `"/providers/Microsoft.Management/managementGroups/mg-platform"`,
+			result:    `{"id":"/providers/Microsoft.Management/managementGroups/mg-platform","is_extension":false,"management_group_name":"mg-platform","name":"mg-platform","names":["mg-platform"],"parent_id":"/","provider_namespace":"Microsoft.Management","resource_group_name":null,"resource_type":"Microsoft.Management/managementGroups","scope":"/","scope_level":"tenant","subscription_id":null,"types":["managementGroups"]}`,
+		},
+		"management-group-scope": {
+			arguments: `"/providers/microsoft.management/managementgroups/mg-platform/providers/Microsoft.Authorization/policyAssignments/deny-public-ip"`,
+			result:    `{"id":"/providers/Microsoft.Management/managementGroups/mg-platform/providers/Microsoft.Authorization/policyAssignments/deny-public-ip","is_extension":false,"management_group_name":"mg-platform","name":"deny-public-ip","names":["deny-public-ip"],"parent_id":"/providers/Microsoft.Management/managementGroups/mg-platform","provider_namespace":"Microsoft.Authorization","resource_group_name":null,"resource_type":"Microsoft.Authorization/policyAssignments","scope":"/providers/Microsoft.Management/managementGroups/mg-platform","scope_level":"management_group","subscription_id":null,"types":["policyAssignments"]}`,
+		},
+		"tenant": {
+			arguments: `"/providers/Microsoft.Authorization/policyDefinitions/allowed-locations"`,
+			result:    `{"id":"/providers/Microsoft.Authorization/policyDefinitions/allowed-locations","is_extension":false,"management_group_name":null,"name":"allowed-locations","names":["allowed-locations"],"parent_id":"/","provider_namespace":"Microsoft.Authorization","resource_group_name":null,"resource_type":"Microsoft.Authorization/policyDefinitions","scope":"/","scope_level":"tenant","subscription_id":null,"types":["policyDefinitions"]}`,
+		},
+		"extension": {
+			arguments: `"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-network/providers/Microsoft.Network/virtualNetworks/vnet-hub/subnets/snet-app/providers/Microsoft.Authorization/roleAssignments/ra1"`,
+			result:    `{"id":"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-network/providers/Microsoft.Network/virtualNetworks/vnet-hub/subnets/snet-app/providers/Microsoft.Authorization/roleAssignments/ra1","is_extension":true,"management_group_name":null,"name":"ra1","names":["ra1"],"parent_id":"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-network/providers/Microsoft.Network/virtualNetworks/vnet-hub/subnets/snet-app","provider_namespace":"Microsoft.Authorization","resource_group_name":"rg-network","resource_type":"Microsoft.Authorization/roleAssignments","scope":"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-network/providers/Microsoft.Network/virtualNetworks/vnet-hub/subnets/snet-app","scope_level":"resource","subscription_id":"00000000-0000-0000-0000-000000000000","types":["roleAssignments"]}`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::azure_resource_id_parse(%s))
+							}
+						`, testCase.arguments),
+						Check: resource.TestCheckOutput("result", testCase.result),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAzureResourceIDParseFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		error     string
+	}{
+		"no-leading-slash": {
+			arguments: `"subscriptions/x"`,
+			error:     `(?s)Call to function "provider::iactools::azure_resource_id_parse" failed.*Error\s+parsing\s+Azure\s+resource\s+ID:\s+the\s+ID\s+"subscriptions/x"\s+must\s+start\s+with\s+/`,
+		},
+		"root": {
+			arguments: `"/"`,
+			error:     `(?s)Call to function "provider::iactools::azure_resource_id_parse" failed.*Error\s+parsing\s+Azure\s+resource\s+ID:\s+the\s+ID\s+"/"\s+has\s+no\s+segments`,
+		},
+		"empty-segment": {
+			arguments: `"/subscriptions//resourceGroups/rg"`,
+			error:     `(?s)Call to function "provider::iactools::azure_resource_id_parse" failed.*Error\s+parsing\s+Azure\s+resource\s+ID:\s+the\s+ID\s+"/subscriptions//resourceGroups/rg"\s+contains\s+an\s+empty\s+segment`,
+		},
+		"no-subscription-id": {
+			arguments: `"/subscriptions"`,
+			error:     `(?s)Call to function "provider::iactools::azure_resource_id_parse" failed.*Error\s+parsing\s+Azure\s+resource\s+ID:\s+the\s+ID\s+"/subscriptions"\s+has\s+no\s+subscription\s+ID\s+after\s+the\s+subscriptions\s+segment`,
+		},
+		"no-resource-group-name": {
+			arguments: `"/subscriptions/x/resourceGroups"`,
+			error:     `(?s)Call to function "provider::iactools::azure_resource_id_parse" failed.*Error\s+parsing\s+Azure\s+resource\s+ID:\s+the\s+ID\s+"/subscriptions/x/resourceGroups"\s+has\s+no\s+resource\s+group\s+name\s+after\s+the\s+resourceGroups\s+segment`,
+		},
+		"unexpected-segment": {
+			arguments: `"/subscriptions/x/locations/westeurope"`,
+			error:     `(?s)Call to function "provider::iactools::azure_resource_id_parse" failed.*Error\s+parsing\s+Azure\s+resource\s+ID:\s+the\s+ID\s+"/subscriptions/x/locations/westeurope"\s+has\s+the\s+segment\s+"locations"\s+where\s+providers\s+is\s+expected`,
+		},
+		"no-namespace": {
+			arguments: `"/subscriptions/x/providers"`,
+			error:     `(?s)Call to function "provider::iactools::azure_resource_id_parse" failed.*Error\s+parsing\s+Azure\s+resource\s+ID:\s+the\s+ID\s+"/subscriptions/x/providers"\s+has\s+no\s+provider\s+namespace\s+after\s+the\s+providers\s+segment`,
+		},
+		"no-type": {
+			arguments: `"/subscriptions/x/providers/Microsoft.Network"`,
+			error:     `(?s)Call to function "provider::iactools::azure_resource_id_parse" failed.*Error\s+parsing\s+Azure\s+resource\s+ID:\s+the\s+ID\s+"/subscriptions/x/providers/Microsoft.Network"\s+has\s+no\s+resource\s+type\s+after\s+the\s+provider\s+namespace\s+"Microsoft.Network"`,
+		},
+		"no-name": {
+			arguments: `"/subscriptions/x/providers/Microsoft.Network/virtualNetworks"`,
+			error:     `(?s)Call to function "provider::iactools::azure_resource_id_parse" failed.*Error\s+parsing\s+Azure\s+resource\s+ID:\s+the\s+ID\s+"/subscriptions/x/providers/Microsoft.Network/virtualNetworks"\s+has\s+no\s+name\s+after\s+the\s+resource\s+type\s+"virtualNetworks"`,
+		},
+		"empty": {
+			arguments: `""`,
+			error:     `(?s)Call to function "provider::iactools::azure_resource_id_parse" failed.*The\s+id\s+argument\s+must\s+be\s+provided\s+and\s+valid`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::azure_resource_id_parse(%s)
+							}
+						`, testCase.arguments),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,360 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"regexp/syntax"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// AS number limits.
+const (
+	asn2ByteMax = 1<<16 - 1
+	asn4ByteMax = 1<<32 - 1
+)
+
+// asnRange is an inclusive range of AS numbers.
+type asnRange struct {
+	from int64
+	to   int64
+}
+
+// asnPrivateRanges are the private use AS numbers of RFC 6996.
+var asnPrivateRanges = []asnRange{
+	{64512, 65534},
+	{4200000000, 4294967294},
+}
+
+// asnReservedRanges are the AS numbers reserved by RFC 7607, RFC 6793, RFC 5398 and RFC 7300 and the IANA registry,
+// including AS_TRANS and the documentation ranges.
+var asnReservedRanges = []asnRange{
+	{0, 0},
+	{23456, 23456},
+	{64496, 64511},
+	{65535, 65535},
+	{65536, 131071},
+	{4294967295, 4294967295},
+}
+
+// BGP community types.
+const (
+	bgpCommunityStandard = "standard"
+	bgpCommunityExtended = "extended"
+	bgpCommunityLarge    = "large"
+)
+
+// bgpWellKnownCommunities maps the names of the well-known communities of RFC 1997, RFC 3765, RFC 7611, RFC 7999 and RFC 8326 to their values.
+var bgpWellKnownCommunities = map[string]int64{
+	"graceful-shutdown":   0xFFFF0000,
+	"accept-own":          0xFFFF0001,
+	"blackhole":           0xFFFF029A,
+	"no-export":           0xFFFFFF01,
+	"no-advertise":        0xFFFFFF02,
+	"no-export-subconfed": 0xFFFFFF03,
+	"no-peer":             0xFFFFFF04,
+}
+
+// bgpExtendedCommunitySubtypes maps the names of the route target and route origin extended communities to their canonical names.
+var bgpExtendedCommunitySubtypes = map[string]string{
+	"rt":     "rt",
+	"target": "rt",
+	"soo":    "soo",
+	"origin": "soo",
+}
+
+// bgpRouteMapSequenceMax is the largest sequence number of route map entries.
+const bgpRouteMapSequenceMax = 65535
+
+// bgpRouteMapActions are the actions of route map entries.
+var bgpRouteMapActions = []string{"permit", "deny"}
+
+// bgpRouteMapActionVerbs maps the route map actions to the verbs used in the problem messages.
+var bgpRouteMapActionVerbs = map[string]string{
+	"permit": "permits",
+	"deny":   "denies",
+}
+
+// ASN holds a parsed AS number.
+type ASN struct {
+	ASN        int64  `tfsdk:"asn"`
+	ASDot      string `tfsdk:"asdot"`
+	IsPrivate  bool   `tfsdk:"is_private"`
+	IsReserved bool   `tfsdk:"is_reserved"`
+	Is4Byte    bool   `tfsdk:"is_4byte"`
+}
+
+// BGPCommunity holds a parsed standard, extended or large BGP community.
+type BGPCommunity struct {
+	Type      string  `tfsdk:"type"`
+	Value     string  `tfsdk:"value"`
+	Subtype   *string `tfsdk:"subtype"`
+	WellKnown *string `tfsdk:"well_known"`
+	ASN       *int64  `tfsdk:"asn"`
+	IPAddress *string `tfsdk:"ip_address"`
+	Local     []int64 `tfsdk:"local"`
+}
+
+// BGPRouteMapEntry holds an entry of a route map with its match lists.
+type BGPRouteMapEntry struct {
+	Sequence       int64
+	Action         string
+	MatchCommunity []string
+	MatchASPath    []string
+	MatchPrefix    []string
+}
+
+// ASNParse parses an AS number in asplain (65001, 4200000000) or asdot (1.10) notation, with an optional AS prefix.
+func ASNParse(value string) (ASN, error) {
+	var result ASN
+	trimmed := strings.TrimSpace(value)
+	if len(trimmed) > 2 && strings.EqualFold(trimmed[:2], "as") {
+		trimmed = strings.TrimSpace(trimmed[2:])
+	}
+
+	var asn int64
+	if high, low, isDot := strings.Cut(trimmed, "."); isDot {
+		highValue, errHigh := strconv.ParseInt(high, 10, 64)
+		lowValue, errLow := strconv.ParseInt(low, 10, 64)
+		if errHigh != nil || errLow != nil || highValue < 0 || highValue > asn2ByteMax || lowValue < 0 || lowValue > asn2ByteMax {
+			return result, fmt.Errorf("invalid AS number %q: asdot notation must be two numbers between 0 and %d", value, asn2ByteMax)
+		}
+		asn = highValue<<16 | lowValue
+	} else {
+		var err error
+		if asn, err = strconv.ParseInt(trimmed, 10, 64); err != nil || asn < 0 || asn > asn4ByteMax {
+			return result, fmt.Errorf("invalid AS number %q: must be between 0 and %d", value, int64(asn4ByteMax))
+		}
+	}
+
+	result.ASN = asn
+	result.ASDot = strconv.FormatInt(asn, 10)
+	if asn > asn2ByteMax {
+		result.ASDot = fmt.Sprintf("%d.%d", asn>>16, asn&asn2ByteMax)
+	}
+	result.IsPrivate = asnInRanges(asn, asnPrivateRanges)
+	result.IsReserved = asnInRanges(asn, asnReservedRanges)
+	result.Is4Byte = asn > asn2ByteMax
+	return result, nil
+}
+
+// BGPCommunityParse parses a standard (65001:100, no-export or a 32-bit number), extended (rt:65001:100, soo:192.0.2.1:100)
+// or large (65001:1:2) BGP community.
+func BGPCommunityParse(value string) (BGPCommunity, error) {
+	trimmed := strings.ToLower(strings.TrimSpace(value))
+	parts := strings.Split(trimmed, ":")
+
+	if subtype, ok := bgpExtendedCommunitySubtypes[parts[0]]; ok {
+		return parseExtendedCommunity(value, subtype, parts[1:])
+	}
+
+	switch len(parts) {
+	case 1:
+		if number, ok := bgpWellKnownCommunities[trimmed]; ok {
+			return newStandardCommunity(number), nil
+		}
+		number, err := strconv.ParseInt(trimmed, 10, 64)
+		if err != nil || number < 0 || number > asn4ByteMax {
+			return BGPCommunity{}, fmt.Errorf("invalid community %q: must be a community like 65001:100 or a well-known community name", value)
+		}
+		return newStandardCommunity(number), nil
+	case 2:
+		high, errHigh := strconv.ParseInt(parts[0], 10, 64)
+		low, errLow := strconv.ParseInt(parts[1], 10, 64)
+		if errHigh != nil || errLow != nil || high < 0 || high > asn2ByteMax || low < 0 || low > asn2ByteMax {
+			return BGPCommunity{}, fmt.Errorf("invalid standard community %q: both parts must be numbers between 0 and %d", value, asn2ByteMax)
+		}
+		return newStandardCommunity(high<<16 | low), nil
+	case 3:
+		numbers := make([]int64, 0, 3)
+		for _, part := range parts {
+			number, err := strconv.ParseInt(part, 10, 64)
+			if err != nil || number < 0 || number > asn4ByteMax {
+				return BGPCommunity{}, fmt.Errorf("invalid large community %q: all parts must be numbers between 0 and %d", value, int64(asn4ByteMax))
+			}
+			numbers = append(numbers, number)
+		}
+		return BGPCommunity{
+			Type:  bgpCommunityLarge,
+			Value: fmt.Sprintf("%d:%d:%d", numbers[0], numbers[1], numbers[2]),
+			ASN:   &numbers[0],
+			Local: numbers[1:],
+		}, nil
+	default:
+		return BGPCommunity{}, fmt.Errorf("invalid community %q: must be a standard, extended or large community", value)
+	}
+}
+
+// BGPRouteMapValidate checks the entries of a route map and returns the problems found:
+// invalid sequence numbers and actions, duplicate sequence numbers, invalid communities, AS path regular expressions and prefixes,
+// and entries that are never evaluated because an entry before them matches every route.
+func BGPRouteMapValidate(entries []BGPRouteMapEntry) []string {
+	problems := make([]string, 0)
+	entries = append([]BGPRouteMapEntry(nil), entries...)
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Sequence < entries[j].Sequence
+	})
+
+	var catchAll *BGPRouteMapEntry
+	for i, entry := range entries {
+		name := fmt.Sprintf("entry %d", entry.Sequence)
+		if entry.Sequence < 1 || entry.Sequence > bgpRouteMapSequenceMax {
+			problems = append(problems, fmt.Sprintf("%s: sequence must be between 1 and %d", name, bgpRouteMapSequenceMax))
+		}
+		if i > 0 && entries[i-1].Sequence == entry.Sequence {
+			problems = append(problems, fmt.Sprintf("%s: duplicate sequence number", name))
+		}
+		action := strings.ToLower(entry.Action)
+		if !slices.Contains(bgpRouteMapActions, action) {
+			problems = append(problems, fmt.Sprintf("%s: invalid action %q, must be permit or deny", name, entry.Action))
+		}
+
+		for _, community := range entry.MatchCommunity {
+			if _, err := BGPCommunityParse(community); err != nil {
+				problems = append(problems, fmt.Sprintf("%s: %v", name, err))
+			}
+		}
+		for _, asPath := range entry.MatchASPath {
+			if _, err := compileASPathRegexp(asPath); err != nil {
+				problems = append(problems, fmt.Sprintf("%s: invalid AS path regular expression %q: %v", name, asPath, err))
+			}
+		}
+		for _, prefix := range entry.MatchPrefix {
+			if err := validatePrefixListEntry(prefix); err != nil {
+				problems = append(problems, fmt.Sprintf("%s: %v", name, err))
+			}
+		}
+
+		if catchAll != nil {
+			problems = append(problems, fmt.Sprintf("%s: never evaluated, entry %d %s every route", name, catchAll.Sequence, bgpRouteMapActionVerbs[strings.ToLower(catchAll.Action)]))
+		} else if len(entry.MatchCommunity) == 0 && len(entry.MatchASPath) == 0 && len(entry.MatchPrefix) == 0 && slices.Contains(bgpRouteMapActions, action) {
+			catchAll = &entries[i]
+		}
+	}
+	return problems
+}
+
+// Helper functions
+
+// asnInRanges reports whether the AS number is in one of the ranges.
+func asnInRanges(asn int64, ranges []asnRange) bool {
+	for _, r := range ranges {
+		if asn >= r.from && asn <= r.to {
+			return true
+		}
+	}
+	return false
+}
+
+// newStandardCommunity creates a standard community from its 32-bit value.
+func newStandardCommunity(number int64) BGPCommunity {
+	asn := number >> 16
+	community := BGPCommunity{
+		Type:  bgpCommunityStandard,
+		Value: fmt.Sprintf("%d:%d", asn, number&asn2ByteMax),
+		ASN:   &asn,
+		Local: []int64{number & asn2ByteMax},
+	}
+	for name, value := range bgpWellKnownCommunities {
+		if value == number {
+			community.WellKnown = &name
+		}
+	}
+	return community
+}
+
+// parseExtendedCommunity parses the global and local administrator of a route target or route origin extended community.
+// The global administrator is a 2-byte AS number with a 32-bit local administrator,
+// or an IPv4 address or a 4-byte AS number with a 16-bit local administrator.
+func parseExtendedCommunity(value, subtype string, parts []string) (BGPCommunity, error) {
+	if len(parts) != 2 {
+		return BGPCommunity{}, fmt.Errorf("invalid extended community %q: must be like %s:65001:100 or %s:192.0.2.1:100", value, subtype, subtype)
+	}
+	community := BGPCommunity{Type: bgpCommunityExtended, Subtype: &subtype}
+
+	localMax := int64(asn2ByteMax)
+	var global string
+	if ip := net.ParseIP(parts[0]); ip != nil && ip.To4() != nil {
+		global = ip.To4().String()
+		community.IPAddress = &global
+	} else {
+		asn, err := strconv.ParseInt(parts[0], 10, 64)
+		if err != nil || asn < 0 || asn > asn4ByteMax {
+			return BGPCommunity{}, fmt.Errorf("invalid extended community %q: the global administrator must be an AS number or an IPv4 address", value)
+		}
+		if asn <= asn2ByteMax {
+			localMax = asn4ByteMax
+		}
+		global = strconv.FormatInt(asn, 10)
+		community.ASN = &asn
+	}
+
+	local, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || local < 0 || local > localMax {
+		return BGPCommunity{}, fmt.Errorf("invalid extended community %q: the local administrator must be a number between 0 and %d", value, localMax)
+	}
+	community.Value = fmt.Sprintf("%s:%s:%d", subtype, global, local)
+	community.Local = []int64{local}
+	return community, nil
+}
+
+// compileASPathRegexp compiles an AS path regular expression, translating the Cisco "_" delimiter.
+// Syntax errors are reported without the translated expression.
+func compileASPathRegexp(expression string) (*regexp.Regexp, error) {
+	compiled, err := regexp.Compile(strings.ReplaceAll(expression, "_", `(^|[ ,{}()]|$)`))
+	var syntaxErr *syntax.Error
+	if errors.As(err, &syntaxErr) {
+		return nil, errors.New(string(syntaxErr.Code))
+	}
+	return compiled, err
+}
+
+// validatePrefixListEntry checks a prefix list entry like "10.0.0.0/8 le 24" or "10.0.0.0/8 ge 16 le 24".
+func validatePrefixListEntry(entry string) error {
+	fields := strings.Fields(entry)
+	if len(fields) == 0 {
+		return fmt.Errorf("prefix list entries must not be empty")
+	}
+	ip, ipnet, err := net.ParseCIDR(fields[0])
+	if err != nil {
+		return fmt.Errorf("invalid prefix %q: %v", entry, err)
+	}
+	ones, bits := ipnet.Mask.Size()
+	if !ip.Equal(ipnet.IP) {
+		return fmt.Errorf("invalid prefix %q: %s has host bits set, the network is %s", entry, fields[0], ipnet)
+	}
+
+	ge, le := ones, ones
+	seen := make(map[string]bool)
+	for i := 1; i < len(fields); i += 2 {
+		keyword := strings.ToLower(fields[i])
+		if (keyword != "ge" && keyword != "le") || seen[keyword] || i+1 >= len(fields) {
+			return fmt.Errorf("invalid prefix %q: the prefix can only be followed by ge and le lengths", entry)
+		}
+		seen[keyword] = true
+		length, err := strconv.Atoi(fields[i+1])
+		if err != nil {
+			return fmt.Errorf("invalid prefix %q: %s must be followed by a prefix length", entry, keyword)
+		}
+		if keyword == "ge" {
+			ge = length
+		} else {
+			le = length
+		}
+	}
+	if seen["ge"] && !seen["le"] {
+		le = bits
+	}
+	if ge < ones || ge > le || le > bits {
+		return fmt.Errorf("invalid prefix %q: the lengths must satisfy %d <= ge <= le <= %d", entry, ones, bits)
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = BGPCommunityParseFunction{}
+)
+
+// NewBGPCommunityParseFunction is a helper function to create a new instance of BGPCommunityParseFunction.
+func NewBGPCommunityParseFunction() function.Function {
+	return BGPCommunityParseFunction{}
+}
+
+// BGPCommunityParseFunction is the struct for the BGP community parse function.
+type BGPCommunityParseFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f BGPCommunityParseFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "bgp_community_parse"
+}
+
+// Definition sets the definition for the function.
+func (f BGPCommunityParseFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse a standard, extended or large BGP community",
+		MarkdownDescription: "Parses a BGP community and outputs its `type` and canonical `value`. " +
+			"Standard communities (RFC 1997) are written as `65001:100`, as a 32-bit number, or as a well-known name like `no-export`, `no-advertise`, `blackhole` or `graceful-shutdown`, reported in `well_known`. " +
+			"Extended communities (RFC 4360) are route targets (`rt:` or `target:`) and route origins (`soo:` or `origin:`) with a 2-byte AS number, 4-byte AS number or IPv4 address as global administrator, reported in `subtype`. " +
+			"Large communities (RFC 8092) are written as `65001:1:2`. " +
+			"The `asn` is the AS number of the community, `ip_address` the IPv4 global administrator, and `local` the list of local values.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "value",
+				MarkdownDescription: "The BGP community",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"type":       types.StringType,
+				"value":      types.StringType,
+				"subtype":    types.StringType,
+				"well_known": types.StringType,
+				"asn":        types.Int64Type,
+				"ip_address": types.StringType,
+				"local":      types.ListType{ElemType: types.Int64Type},
+			},
+		},
+	}
+}
+
+// Run executes the BGP community parse function.
+func (f BGPCommunityParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+
+	// Parse the argument
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &value))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input argument
+	if value == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The value argument must be provided and valid"))
+		return
+	}
+
+	// Parse the community
+	community, err := BGPCommunityParse(value)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error parsing BGP community: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, community))
+}
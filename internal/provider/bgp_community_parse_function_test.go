@@ -0,0 +1,134 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestBGPCommunityParseFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		result    string
+	}{
+		"standard": {
+			arguments: `"65001:100"`,
+			result:    `{"asn":65001,"ip_address":null,"local":[100],"subtype":null,"type":"standard","value":"65001:100","well_known":null}`,
+		},
+		"well-known-name": {
+			arguments: `"no-export"`,
+			result:    `{"asn":65535,"ip_address":null,"local":[65281],"subtype":null,"type":"standard","value":"65535:65281","well_known":"no-export"}`,
+		},
+		"well-known-value": {
+			arguments: `"65535:666"`,
+			result:    `{"asn":65535,"ip_address":null,"local":[666],"subtype":null,"type":"standard","value":"65535:666","well_known":"blackhole"}`,
+		},
+		"number": {
+			arguments: `"4259905636"`,
+			result:    `{"asn":65001,"ip_address":null,"local":[100],"subtype":null,"type":"standard","value":"65001:100","well_known":null}`,
+		},
+		"route-target": {
+			arguments: `"rt:65001:4000000000"`,
+			result:    `{"asn":65001,"ip_address":null,"local":[4000000000],"subtype":"rt","type":"extended","value":"rt:65001:4000000000","well_known":null}`,
+		},
+		"route-target-4byte": {
+			arguments: `"target:4200000000:100"`,
+			result:    `{"asn":4200000000,"ip_address":null,"local":[100],"subtype":"rt","type":"extended","value":"rt:4200000000:100","well_known":null}`,
+		},
+		"route-origin-ip": {
+			arguments: `"soo:192.0.2.1:100"`,
+			result:    `{"asn":null,"ip_address":"192.0.2.1","local":[100],"subtype":"soo","type":"extended","value":"soo:192.0.2.1:100","well_known":null}`,
+		},
+		"large": {
+			arguments: `"4200000000:1:2"`,
+			result:    `{"asn":4200000000,"ip_address":null,"local":[1,2],"subtype":null,"type":"large","value":"4200000000:1:2","well_known":null}`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::bgp_community_parse(%s))
+							}
+						`, testCase.arguments),
+						Check: resource.TestCheckOutput("result", testCase.result),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestBGPCommunityParseFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		error     string
+	}{
+		"empty": {
+			arguments: `""`,
+			error:     `(?s)Call to function "provider::iactools::bgp_community_parse" failed.*The\s+value\s+argument\s+must\s+be\s+provided\s+and\s+valid`,
+		},
+		"standard-out-of-range": {
+			arguments: `"65536:1"`,
+			error:     `(?s)Call to function "provider::iactools::bgp_community_parse" failed.*Error\s+parsing\s+BGP\s+community:\s+invalid\s+standard\s+community\s+"65536:1":\s+both\s+parts\s+must\s+be\s+numbers\s+between\s+0\s+and\s+65535`,
+		},
+		"unknown-name": {
+			arguments: `"no-such-community"`,
+			error:     `(?s)Call to function "provider::iactools::bgp_community_parse" failed.*invalid\s+community\s+"no-such-community":\s+must\s+be\s+a\s+community\s+like\s+65001:100\s+or\s+a\s+well-known\s+community\s+name`,
+		},
+		"extended-4byte-local": {
+			arguments: `"rt:4200000000:65536"`,
+			error:     `(?s)Call to function "provider::iactools::bgp_community_parse" failed.*the\s+local\s+administrator\s+must\s+be\s+a\s+number\s+between\s+0\s+and\s+65535`,
+		},
+		"extended-global": {
+			arguments: `"rt:foo:1"`,
+			error:     `(?s)Call to function "provider::iactools::bgp_community_parse" failed.*the\s+global\s+administrator\s+must\s+be\s+an\s+AS\s+number\s+or\s+an\s+IPv4\s+address`,
+		},
+		"extended-parts": {
+			arguments: `"rt:65001"`,
+			error:     `(?s)Call to function "provider::iactools::bgp_community_parse" failed.*invalid\s+extended\s+community\s+"rt:65001":\s+must\s+be\s+like\s+rt:65001:100\s+or\s+rt:192.0.2.1:100`,
+		},
+		"large-out-of-range": {
+			arguments: `"1:2:4294967296"`,
+			error:     `(?s)Call to function "provider::iactools::bgp_community_parse" failed.*invalid\s+large\s+community\s+"1:2:4294967296":\s+all\s+parts\s+must\s+be\s+numbers\s+between\s+0\s+and\s+4294967295`,
+		},
+		"too-many-parts": {
+			arguments: `"1:2:3:4"`,
+			error:     `(?s)Call to function "provider::iactools::bgp_community_parse" failed.*must\s+be\s+a\s+standard,\s+extended\s+or\s+large\s+community`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::bgp_community_parse(%s)
+							}
+						`, testCase.arguments),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = BGPRouteMapValidateFunction{}
+)
+
+// NewBGPRouteMapValidateFunction is a helper function to create a new instance of BGPRouteMapValidateFunction.
+func NewBGPRouteMapValidateFunction() function.Function {
+	return BGPRouteMapValidateFunction{}
+}
+
+// BGPRouteMapValidateFunction is the struct for the BGP route map validate function.
+type BGPRouteMapValidateFunction struct{}
+
+// bgpRouteMapValidation is the result of the BGP route map validate function.
+type bgpRouteMapValidation struct {
+	Valid    bool     `tfsdk:"valid"`
+	Problems []string `tfsdk:"problems"`
+}
+
+// Metadata sets the metadata for the function.
+func (f BGPRouteMapValidateFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "bgp_route_map_validate"
+}
+
+// Definition sets the definition for the function.
+func (f BGPRouteMapValidateFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Validate the match lists of a BGP route map",
+		MarkdownDescription: "Checks the entries of a route map in sequence order, and outputs whether it is `valid` and the list of `problems` found: " +
+			"sequence numbers outside of 1-65535 or used twice, actions other than `permit` and `deny`, " +
+			"invalid communities (see `bgp_community_parse`), AS path regular expressions and prefix list entries, " +
+			"and entries that are never evaluated because an entry before them has no match conditions and matches every route. " +
+			"AS path regular expressions can use the `_` delimiter, prefix list entries can have `ge` and `le` lengths like `10.0.0.0/8 le 24`. " +
+			"Use the result in a precondition to reject invalid route maps with all problems listed.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name: "entries",
+				MarkdownDescription: "The list of route map entries, each an object with `sequence`, `action` and the optional match lists " +
+					"`match_community`, `match_as_path` and `match_prefix`",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"valid":    types.BoolType,
+				"problems": types.ListType{ElemType: types.StringType},
+			},
+		},
+	}
+}
+
+// Run executes the BGP route map validate function.
+func (f BGPRouteMapValidateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var entriesArgument types.Dynamic
+
+	// Parse the argument
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &entriesArgument))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input argument
+	entries, err := parseBGPRouteMapEntries(entriesArgument)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error parsing route map entries: %s", err.Error())))
+		return
+	}
+	if len(entries) == 0 {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The entries argument must be provided and valid"))
+		return
+	}
+
+	// Validate the route map
+	problems := BGPRouteMapValidate(entries)
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, bgpRouteMapValidation{Valid: len(problems) == 0, Problems: problems}))
+}
+
+// parseBGPRouteMapEntries converts the entries argument into route map entries.
+func parseBGPRouteMapEntries(value types.Dynamic) ([]BGPRouteMapEntry, error) {
+	converted, err := dynamicToGo(value)
+	if err != nil || converted == nil {
+		return nil, err
+	}
+	list, err := goList(converted, "entries")
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]BGPRouteMapEntry, 0, len(list))
+	for i, item := range list {
+		path := fmt.Sprintf("entries[%d]", i)
+		object, err := goObject(item, path)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkObjectKeys(object, path, "sequence", "action", "match_community", "match_as_path", "match_prefix"); err != nil {
+			return nil, err
+		}
+
+		var entry BGPRouteMapEntry
+		if entry.Sequence, err = goInt64(object["sequence"], path+".sequence"); err != nil {
+			return nil, err
+		}
+		if entry.Action, err = goString(object["action"], path+".action"); err != nil {
+			return nil, err
+		}
+		for _, field := range []struct {
+			name  string
+			value *[]string
+		}{
+			{"match_community", &entry.MatchCommunity},
+			{"match_as_path", &entry.MatchASPath},
+			{"match_prefix", &entry.MatchPrefix},
+		} {
+			if object[field.name] != nil {
+				if *field.value, err = goStringList(object[field.name], path+"."+field.name); err != nil {
+					return nil, err
+				}
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
@@ -0,0 +1,102 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestBGPRouteMapValidateFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		result    string
+	}{
+		"valid": {
+			arguments: `[{sequence = 10, action = "permit", match_prefix = ["10.0.0.0/8 le 24"], match_community = ["65001:100"]}, {sequence = 20, action = "deny", match_as_path = ["_65002$"]}, {sequence = 30, action = "permit"}]`,
+			result:    `{"problems":[],"valid":true}`,
+		},
+		"unordered": {
+			arguments: `[{sequence = 20, action = "permit"}, {sequence = 10, action = "deny", match_prefix = ["2001:db8::/32 ge 48"]}]`,
+			result:    `{"problems":[],"valid":true}`,
+		},
+		"shadowed": {
+			arguments: `[{sequence = 10, action = "deny"}, {sequence = 20, action = "permit", match_community = ["no-export"]}]`,
+			result:    `{"problems":["entry 20: never evaluated, entry 10 denies every route"],"valid":false}`,
+		},
+		"invalid-entries": {
+			arguments: `[{sequence = 10, action = "allow", match_community = ["65536:1"]}, {sequence = 10, action = "permit", match_as_path = ["(65001"], match_prefix = ["10.0.0.1/8", "10.0.0.0/16 ge 8"]}, {sequence = 70000, action = "deny", match_prefix = ["10.0.0.0/8 le 24"]}]`,
+			result:    `{"problems":["entry 10: invalid action \"allow\", must be permit or deny","entry 10: invalid standard community \"65536:1\": both parts must be numbers between 0 and 65535","entry 10: duplicate sequence number","entry 10: invalid AS path regular expression \"(65001\": missing closing )","entry 10: invalid prefix \"10.0.0.1/8\": 10.0.0.1/8 has host bits set, the network is 10.0.0.0/8","entry 10: invalid prefix \"10.0.0.0/16 ge 8\": the lengths must satisfy 16 \u003c= ge \u003c= le \u003c= 32","entry 70000: sequence must be between 1 and 65535"],"valid":false}`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::bgp_route_map_validate(%s))
+							}
+						`, testCase.arguments),
+						Check: resource.TestCheckOutput("result", testCase.result),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestBGPRouteMapValidateFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		error     string
+	}{
+		"empty": {
+			arguments: `[]`,
+			error:     `(?s)Call to function "provider::iactools::bgp_route_map_validate" failed.*The\s+entries\s+argument\s+must\s+be\s+provided\s+and\s+valid`,
+		},
+		"not-a-list": {
+			arguments: `{sequence = 10}`,
+			error:     `(?s)Call to function "provider::iactools::bgp_route_map_validate" failed.*Error\s+parsing\s+route\s+map\s+entries:\s+entries\s+must\s+be\s+a\s+list`,
+		},
+		"unsupported-attribute": {
+			arguments: `[{sequence = 10, action = "permit", match_tag = [1]}]`,
+			error:     `(?s)Call to function "provider::iactools::bgp_route_map_validate" failed.*entries\[0\]\s+has\s+unsupported\s+attributes:\s+\[match_tag\]`,
+		},
+		"fractional-sequence": {
+			arguments: `[{sequence = 10.5, action = "permit"}]`,
+			error:     `(?s)Call to function "provider::iactools::bgp_route_map_validate" failed.*entries\[0\].sequence\s+must\s+be\s+a\s+whole\s+number`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::bgp_route_map_validate(%s)
+							}
+						`, testCase.arguments),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
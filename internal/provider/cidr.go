@@ -0,0 +1,151 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"net"
+	"sort"
+)
+
+// parseCIDRList parses a list of CIDRs into their canonical network form.
+func parseCIDRList(cidrs []string) ([]*net.IPNet, error) {
+	ipnets := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, ipnet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR: %v", err)
+		}
+		ipnets = append(ipnets, ipnet)
+	}
+	return ipnets, nil
+}
+
+// sortIPNets sorts IPv4 networks before IPv6 networks, then by address and prefix length.
+func sortIPNets(ipnets []*net.IPNet) {
+	sort.SliceStable(ipnets, func(i, j int) bool {
+		return compareIPNets(ipnets[i], ipnets[j]) < 0
+	})
+}
+
+// compareIPNets compares two networks by address family, address and prefix length.
+func compareIPNets(a, b *net.IPNet) int {
+	if len(a.IP) != len(b.IP) {
+		return len(a.IP) - len(b.IP)
+	}
+	if c := bytes.Compare(a.IP, b.IP); c != 0 {
+		return c
+	}
+	aOnes, _ := a.Mask.Size()
+	bOnes, _ := b.Mask.Size()
+	return aOnes - bOnes
+}
+
+// ipNetContains reports whether the outer network fully contains the inner network.
+func ipNetContains(outer, inner *net.IPNet) bool {
+	if len(outer.IP) != len(inner.IP) {
+		return false
+	}
+	outerOnes, _ := outer.Mask.Size()
+	innerOnes, _ := inner.Mask.Size()
+	return outerOnes <= innerOnes && outer.Contains(inner.IP)
+}
+
+// ipNetsOverlap reports whether two networks share any address.
+func ipNetsOverlap(a, b *net.IPNet) bool {
+	return ipNetContains(a, b) || ipNetContains(b, a)
+}
+
+// aggregateCIDRs removes duplicate and contained networks, and merges sibling networks into their parent.
+func aggregateCIDRs(ipnets []*net.IPNet) []*net.IPNet {
+	sorted := make([]*net.IPNet, len(ipnets))
+	copy(sorted, ipnets)
+	sortIPNets(sorted)
+
+	var stack []*net.IPNet
+	for _, ipnet := range sorted {
+		if len(stack) > 0 && ipNetContains(stack[len(stack)-1], ipnet) {
+			continue
+		}
+		for len(stack) > 0 {
+			parent := siblingParent(stack[len(stack)-1], ipnet)
+			if parent == nil {
+				break
+			}
+			stack = stack[:len(stack)-1]
+			ipnet = parent
+		}
+		stack = append(stack, ipnet)
+	}
+	return stack
+}
+
+// siblingParent returns the parent network if the two networks are the lower and upper half of it.
+func siblingParent(lower, upper *net.IPNet) *net.IPNet {
+	if len(lower.IP) != len(upper.IP) {
+		return nil
+	}
+	ones, bits := lower.Mask.Size()
+	upperOnes, _ := upper.Mask.Size()
+	if ones == 0 || ones != upperOnes {
+		return nil
+	}
+
+	parent := &net.IPNet{IP: lower.IP.Mask(net.CIDRMask(ones-1, bits)), Mask: net.CIDRMask(ones-1, bits)}
+	halves, err := splitCIDR(parent)
+	if err != nil || !halves[0].IP.Equal(lower.IP) || !halves[1].IP.Equal(upper.IP) {
+		return nil
+	}
+	return parent
+}
+
+// allocateCIDR returns the lowest network with the prefix length inside the parent that doesn't overlap any of the used networks.
+// It returns nil when the parent has no such free network.
+func allocateCIDR(parent *net.IPNet, ones int, used []*net.IPNet) *net.IPNet {
+	parentOnes, _ := parent.Mask.Size()
+	if parentOnes > ones {
+		return nil
+	}
+
+	var overlapping []*net.IPNet
+	for _, ipnet := range used {
+		if ipNetContains(ipnet, parent) {
+			return nil
+		}
+		if ipNetContains(parent, ipnet) {
+			overlapping = append(overlapping, ipnet)
+		}
+	}
+	if parentOnes == ones {
+		if len(overlapping) > 0 {
+			return nil
+		}
+		return parent
+	}
+
+	halves, err := splitCIDR(parent)
+	if err != nil {
+		return nil
+	}
+	for _, half := range halves {
+		if allocated := allocateCIDR(half, ones, overlapping); allocated != nil {
+			return allocated
+		}
+	}
+	return nil
+}
+
+// subnetAt returns the subnet with the prefix length at the index inside the parent network.
+func subnetAt(parent *net.IPNet, ones int, index int64) *net.IPNet {
+	_, bits := parent.Mask.Size()
+	offset := new(big.Int).Lsh(big.NewInt(index), uint(bits-ones))
+	address := new(big.Int).Add(new(big.Int).SetBytes(parent.IP), offset)
+
+	ip := make(net.IP, len(parent.IP))
+	address.FillBytes(ip)
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(ones, bits)}
+}
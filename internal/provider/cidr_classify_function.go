@@ -0,0 +1,88 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = CIDRClassifyFunction{}
+)
+
+// NewCIDRClassifyFunction is a helper function to create a new instance of CIDRClassifyFunction.
+func NewCIDRClassifyFunction() function.Function {
+	return CIDRClassifyFunction{}
+}
+
+// CIDRClassifyFunction is the struct for the CIDR classify function.
+type CIDRClassifyFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f CIDRClassifyFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_classify"
+}
+
+// Definition sets the definition for the function.
+func (f CIDRClassifyFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	attributeTypes := map[string]attr.Type{
+		"relation": types.StringType,
+	}
+	maps.Copy(attributeTypes, specialPurposeAttributeTypes)
+
+	resp.Definition = function.Definition{
+		Summary: "Find the special-purpose address ranges overlapping a prefix",
+		MarkdownDescription: "Outputs the entries of the special-purpose address catalog (see `cidr_special_purpose`), including the platform entries, overlapping a CIDR or an IP address, " +
+			"with the `relation` of the prefix to the entry: `equal`, `within` when the prefix is inside the entry, or `contains` when the entry is inside the prefix. " +
+			"An empty list means the prefix is ordinary global unicast space. " +
+			"Use it in preconditions to reject subnets colliding with reserved or platform-internal ranges, " +
+			"e.g. the Docker bridge network or the service CIDR of the Kubernetes cluster.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr",
+				MarkdownDescription: "The CIDR or IP address to classify",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{
+				AttrTypes: attributeTypes,
+			},
+		},
+	}
+}
+
+// Run executes the CIDR classify function.
+func (f CIDRClassifyFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr string
+
+	// Parse the argument
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &cidr))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input argument
+	if cidr == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The cidr argument must be provided and valid"))
+		return
+	}
+
+	// Classify the CIDR
+	matches, err := CIDRClassify(cidr)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error classifying CIDR: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, matches))
+}
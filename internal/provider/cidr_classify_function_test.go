@@ -0,0 +1,106 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestCIDRClassifyFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		result    string
+	}{
+		"global": {
+			arguments: `"20.0.0.0/16"`,
+			result:    `[]`,
+		},
+		"within": {
+			arguments: `"100.64.1.0/24"`,
+			result:    `[{"cidr":"100.64.0.0/10","destination":true,"forwardable":true,"globally_reachable":false,"name":"Shared Address Space","reference":"RFC 6598","registry":"iana","relation":"within","reserved_by_protocol":false,"source":true}]`,
+		},
+		"ip-address": {
+			arguments: `"168.63.129.16"`,
+			result:    `[{"cidr":"168.63.129.16/32","destination":null,"forwardable":null,"globally_reachable":null,"name":"Azure platform virtual IP (WireServer)","reference":null,"registry":"azure","relation":"equal","reserved_by_protocol":null,"source":null}]`,
+		},
+		"host-bits": {
+			arguments: `"172.17.5.1/16"`,
+			result:    `[{"cidr":"172.16.0.0/12","destination":true,"forwardable":true,"globally_reachable":false,"name":"Private-Use","reference":"RFC 1918","registry":"iana","relation":"within","reserved_by_protocol":false,"source":true},{"cidr":"172.17.0.0/16","destination":null,"forwardable":null,"globally_reachable":null,"name":"Docker default bridge network","reference":null,"registry":"docker","relation":"equal","reserved_by_protocol":null,"source":null}]`,
+		},
+		"contains": {
+			arguments: `"192.0.0.0/23"`,
+			result:    `[{"cidr":"192.0.0.0/24","destination":false,"forwardable":false,"globally_reachable":false,"name":"IETF Protocol Assignments","reference":"RFC 6890","registry":"iana","relation":"contains","reserved_by_protocol":false,"source":false},{"cidr":"192.0.0.0/29","destination":true,"forwardable":true,"globally_reachable":false,"name":"IPv4 Service Continuity Prefix","reference":"RFC 7335","registry":"iana","relation":"contains","reserved_by_protocol":false,"source":true},{"cidr":"192.0.0.8/32","destination":false,"forwardable":false,"globally_reachable":false,"name":"IPv4 dummy address","reference":"RFC 7600","registry":"iana","relation":"contains","reserved_by_protocol":false,"source":true},{"cidr":"192.0.0.9/32","destination":true,"forwardable":true,"globally_reachable":true,"name":"Port Control Protocol Anycast","reference":"RFC 7723","registry":"iana","relation":"contains","reserved_by_protocol":false,"source":true},{"cidr":"192.0.0.10/32","destination":true,"forwardable":true,"globally_reachable":true,"name":"Traversal Using Relays around NAT Anycast","reference":"RFC 8155","registry":"iana","relation":"contains","reserved_by_protocol":false,"source":true},{"cidr":"192.0.0.170/32","destination":false,"forwardable":false,"globally_reachable":false,"name":"NAT64/DNS64 Discovery","reference":"RFC 8880","registry":"iana","relation":"contains","reserved_by_protocol":true,"source":false},{"cidr":"192.0.0.171/32","destination":false,"forwardable":false,"globally_reachable":false,"name":"NAT64/DNS64 Discovery","reference":"RFC 8880","registry":"iana","relation":"contains","reserved_by_protocol":true,"source":false}]`,
+		},
+		"ipv6": {
+			arguments: `"fd00:ec2::/64"`,
+			result:    `[{"cidr":"fc00::/7","destination":true,"forwardable":true,"globally_reachable":false,"name":"Unique-Local","reference":"RFC 4193","registry":"iana","relation":"within","reserved_by_protocol":false,"source":true},{"cidr":"fd00:ec2::253/128","destination":null,"forwardable":null,"globally_reachable":null,"name":"Amazon Route 53 Resolver","reference":null,"registry":"aws","relation":"contains","reserved_by_protocol":null,"source":null},{"cidr":"fd00:ec2::254/128","destination":null,"forwardable":null,"globally_reachable":null,"name":"AWS Instance Metadata Service","reference":null,"registry":"aws","relation":"contains","reserved_by_protocol":null,"source":null}]`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::cidr_classify(%s))
+							}
+						`, testCase.arguments),
+						Check: resource.TestCheckOutput("result", testCase.result),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestCIDRClassifyFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		error     string
+	}{
+		"empty": {
+			arguments: `""`,
+			error:     `(?s)Call to function "provider::iactools::cidr_classify" failed.*The\s+cidr\s+argument\s+must\s+be\s+provided\s+and\s+valid`,
+		},
+		"invalid-cidr": {
+			arguments: `"10.0.0.0/33"`,
+			error:     `(?s)Call to function "provider::iactools::cidr_classify" failed.*Error\s+classifying\s+CIDR:\s+invalid\s+CIDR`,
+		},
+		"invalid-ip": {
+			arguments: `"10.0.0.256"`,
+			error:     `(?s)Call to function "provider::iactools::cidr_classify" failed.*Error\s+classifying\s+CIDR:\s+invalid\s+IP\s+address:\s+10.0.0.256`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::cidr_classify(%s)
+							}
+						`, testCase.arguments),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
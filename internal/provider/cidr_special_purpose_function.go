@@ -0,0 +1,97 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = CIDRSpecialPurposeFunction{}
+)
+
+// specialPurposeAttributeTypes are the attribute types of the special-purpose catalog entries.
+var specialPurposeAttributeTypes = map[string]attr.Type{
+	"cidr":                 types.StringType,
+	"name":                 types.StringType,
+	"registry":             types.StringType,
+	"reference":            types.StringType,
+	"source":               types.BoolType,
+	"destination":          types.BoolType,
+	"forwardable":          types.BoolType,
+	"globally_reachable":   types.BoolType,
+	"reserved_by_protocol": types.BoolType,
+}
+
+// NewCIDRSpecialPurposeFunction is a helper function to create a new instance of CIDRSpecialPurposeFunction.
+func NewCIDRSpecialPurposeFunction() function.Function {
+	return CIDRSpecialPurposeFunction{}
+}
+
+// CIDRSpecialPurposeFunction is the struct for the CIDR special purpose function.
+type CIDRSpecialPurposeFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f CIDRSpecialPurposeFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_special_purpose"
+}
+
+// Definition sets the definition for the function.
+func (f CIDRSpecialPurposeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "List the special-purpose address ranges",
+		MarkdownDescription: "Outputs the special-purpose address catalog embedded in the provider: " +
+			"the entries of the IANA IPv4 and IPv6 special-purpose address registries (RFC 6890) with the `registry` `iana`, " +
+			"and, when `include_platforms` is true, the address ranges used internally by platforms, with the `registry` `azure`, `aws`, `gcp`, `docker` or `kubernetes`, " +
+			"like the Azure platform virtual IP 168.63.129.16, the instance metadata endpoints, the Docker default address pools and the default service CIDRs of Kubernetes distributions. " +
+			"Each entry has its `cidr` and `name`, the IANA entries also have the `reference` RFC and the `source`, `destination`, `forwardable`, `globally_reachable` and `reserved_by_protocol` attributes of the registry, " +
+			"which are null for the platform entries. Use `cidr_classify` to find the entries overlapping a prefix.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "family",
+				MarkdownDescription: "The address family, `ipv4` or `ipv6`, or null for both",
+				AllowNullValue:      true,
+			},
+			function.BoolParameter{
+				Name:                "include_platforms",
+				MarkdownDescription: "Whether to include the address ranges used internally by platforms, null for false",
+				AllowNullValue:      true,
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{
+				AttrTypes: specialPurposeAttributeTypes,
+			},
+		},
+	}
+}
+
+// Run executes the CIDR special purpose function.
+func (f CIDRSpecialPurposeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var family *string
+	var includePlatforms *bool
+
+	// Parse the arguments
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &family, &includePlatforms))
+	if resp.Error != nil {
+		return
+	}
+
+	// List the special-purpose entries
+	entries, err := CIDRSpecialPurpose(stringValueOrEmpty(family), includePlatforms != nil && *includePlatforms)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error listing special-purpose address ranges: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, entries))
+}
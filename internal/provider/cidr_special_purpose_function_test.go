@@ -0,0 +1,101 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestCIDRSpecialPurposeFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		result    string
+	}{
+		"ipv6": {
+			arguments: `"ipv6", true`,
+			result:    `[{"cidr":"2001:db8::/32","destination":false,"forwardable":false,"globally_reachable":false,"name":"Documentation","reference":"RFC 3849","registry":"iana","reserved_by_protocol":false,"source":false},{"cidr":"fd00:ec2::254/128","destination":null,"forwardable":null,"globally_reachable":null,"name":"AWS Instance Metadata Service","reference":null,"registry":"aws","reserved_by_protocol":null,"source":null}]`,
+		},
+		"case-insensitive": {
+			arguments: `"IPv6", true`,
+			result:    `[{"cidr":"2001:db8::/32","destination":false,"forwardable":false,"globally_reachable":false,"name":"Documentation","reference":"RFC 3849","registry":"iana","reserved_by_protocol":false,"source":false},{"cidr":"fd00:ec2::254/128","destination":null,"forwardable":null,"globally_reachable":null,"name":"AWS Instance Metadata Service","reference":null,"registry":"aws","reserved_by_protocol":null,"source":null}]`,
+		},
+		"ipv4": {
+			arguments: `"ipv4", true`,
+			result:    `[{"cidr":"168.63.129.16/32","destination":null,"forwardable":null,"globally_reachable":null,"name":"Azure platform virtual IP (WireServer)","reference":null,"registry":"azure","reserved_by_protocol":null,"source":null}]`,
+		},
+		"both": {
+			arguments: `null, true`,
+			result:    `[{"cidr":"168.63.129.16/32","destination":null,"forwardable":null,"globally_reachable":null,"name":"Azure platform virtual IP (WireServer)","reference":null,"registry":"azure","reserved_by_protocol":null,"source":null},{"cidr":"2001:db8::/32","destination":false,"forwardable":false,"globally_reachable":false,"name":"Documentation","reference":"RFC 3849","registry":"iana","reserved_by_protocol":false,"source":false},{"cidr":"fd00:ec2::254/128","destination":null,"forwardable":null,"globally_reachable":null,"name":"AWS Instance Metadata Service","reference":null,"registry":"aws","reserved_by_protocol":null,"source":null}]`,
+		},
+		"iana-only": {
+			arguments: `null, false`,
+			result:    `[{"cidr":"2001:db8::/32","destination":false,"forwardable":false,"globally_reachable":false,"name":"Documentation","reference":"RFC 3849","registry":"iana","reserved_by_protocol":false,"source":false}]`,
+		},
+		"iana-only-by-default": {
+			arguments: `"ipv6", null`,
+			result:    `[{"cidr":"2001:db8::/32","destination":false,"forwardable":false,"globally_reachable":false,"name":"Documentation","reference":"RFC 3849","registry":"iana","reserved_by_protocol":false,"source":false}]`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode([
+									for entry in provider::iactools::cidr_special_purpose(%s) : entry
+									if contains(["168.63.129.16/32", "2001:db8::/32", "fd00:ec2::254/128"], entry.cidr)
+								])
+							}
+						`, testCase.arguments),
+						Check: resource.TestCheckOutput("result", testCase.result),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestCIDRSpecialPurposeFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		error     string
+	}{
+		"unknown-family": {
+			arguments: `"ipv5", null`,
+			error:     `(?s)Call to function "provider::iactools::cidr_special_purpose" failed.*Error\s+listing\s+special-purpose\s+address\s+ranges:\s+unknown\s+address\s+family\s+"ipv5",\s+must\s+be\s+ipv4\s+or\s+ipv6`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::cidr_special_purpose(%s)
+							}
+						`, testCase.arguments),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"net"
+)
+
+// CIDRUtilizationReport holds the address usage of a parent network.
+// The address counts are floats with the precision of the integers, so IPv6 counts are exact.
+type CIDRUtilizationReport struct {
+	TotalAddresses   *big.Float `tfsdk:"total_addresses"`
+	UsedAddresses    *big.Float `tfsdk:"used_addresses"`
+	FreeAddresses    *big.Float `tfsdk:"free_addresses"`
+	Utilization      float64    `tfsdk:"utilization"`
+	FreeBlocks       []string   `tfsdk:"free_blocks"`
+	LargestFreeBlock *string    `tfsdk:"largest_free_block"`
+	Fragmentation    float64    `tfsdk:"fragmentation"`
+}
+
+// CIDRUtilization reports the address usage of a parent network by the allocated networks: the total, used and free addresses,
+// the utilization percentage, the free blocks and the largest one, and the fragmentation of the free addresses,
+// from 0 when they form a single block to nearly 1 when they are scattered in many small blocks.
+func CIDRUtilization(parentCIDR string, allocatedCIDRs []string) (CIDRUtilizationReport, error) {
+	var report CIDRUtilizationReport
+	_, parent, err := net.ParseCIDR(parentCIDR)
+	if err != nil {
+		return report, fmt.Errorf("invalid parent CIDR: %v", err)
+	}
+
+	allocated := make([]*net.IPNet, 0, len(allocatedCIDRs))
+	for _, cidr := range allocatedCIDRs {
+		_, ipnet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return report, fmt.Errorf("invalid allocated CIDR: %v", err)
+		}
+		if !ipNetContains(parent, ipnet) {
+			return report, fmt.Errorf("allocated CIDR %s is not within parent CIDR %s", cidr, parentCIDR)
+		}
+		for j, other := range allocated {
+			if ipNetsOverlap(ipnet, other) {
+				return report, fmt.Errorf("allocated CIDRs %s and %s overlap", allocatedCIDRs[j], cidr)
+			}
+		}
+		allocated = append(allocated, ipnet)
+	}
+
+	total := cidrAddressCount(parent)
+	used := new(big.Int)
+	for _, ipnet := range allocated {
+		used.Add(used, cidrAddressCount(ipnet))
+	}
+	free := new(big.Int).Sub(total, used)
+	report.TotalAddresses = new(big.Float).SetInt(total)
+	report.UsedAddresses = new(big.Float).SetInt(used)
+	report.FreeAddresses = new(big.Float).SetInt(free)
+	report.Utilization = roundFloat(100*bigIntRatio(used, total), 2)
+
+	report.FreeBlocks = make([]string, 0)
+	largest := new(big.Int)
+	for _, block := range findFreeCIDRs(parent, allocated) {
+		report.FreeBlocks = append(report.FreeBlocks, block.String())
+		if size := cidrAddressCount(block); size.Cmp(largest) > 0 {
+			largest = size
+			report.LargestFreeBlock = stringPointer(block.String())
+		}
+	}
+	if free.Sign() > 0 {
+		report.Fragmentation = roundFloat(1-bigIntRatio(largest, free), 4)
+	}
+	return report, nil
+}
+
+// Helper functions
+
+// cidrAddressCount returns the number of addresses of a network.
+func cidrAddressCount(ipnet *net.IPNet) *big.Int {
+	ones, addressBits := ipnet.Mask.Size()
+	return addressCount(addressBits - ones)
+}
+
+// bigIntRatio returns the ratio of two integers as a float.
+func bigIntRatio(numerator, denominator *big.Int) float64 {
+	ratio, _ := new(big.Rat).SetFrac(numerator, denominator).Float64()
+	return ratio
+}
+
+// roundFloat rounds a float to the number of decimals.
+func roundFloat(value float64, decimals int) float64 {
+	scale := math.Pow10(decimals)
+	return math.Round(value*scale) / scale
+}
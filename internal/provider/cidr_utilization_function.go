@@ -0,0 +1,95 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = CIDRUtilizationFunction{}
+)
+
+// NewCIDRUtilizationFunction is a helper function to create a new instance of CIDRUtilizationFunction.
+func NewCIDRUtilizationFunction() function.Function {
+	return CIDRUtilizationFunction{}
+}
+
+// CIDRUtilizationFunction is the struct for the CIDR utilization function.
+type CIDRUtilizationFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f CIDRUtilizationFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_utilization"
+}
+
+// Definition sets the definition for the function.
+func (f CIDRUtilizationFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Report the address usage of a network",
+		MarkdownDescription: "Outputs the usage of a parent network by the allocated CIDRs: the `total_addresses`, `used_addresses` and `free_addresses`, " +
+			"the `utilization` percentage rounded to two decimals, the `free_blocks` as the fewest CIDRs covering the free addresses in address order, " +
+			"and the `largest_free_block`, which is null when the parent is fully allocated. " +
+			"The `fragmentation` is the share of the free addresses outside of the largest free block, " +
+			"0 when the free addresses form a single block and close to 1 when they are scattered in many small blocks. " +
+			"The allocated CIDRs must be within the parent and must not overlap.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "parent",
+				MarkdownDescription: "The parent network, e.g. the address space of a virtual network",
+			},
+			function.ListParameter{
+				Name:                "allocated",
+				ElementType:         types.StringType,
+				MarkdownDescription: "The list of allocated CIDRs, e.g. the subnets of the virtual network",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"total_addresses":    types.NumberType,
+				"used_addresses":     types.NumberType,
+				"free_addresses":     types.NumberType,
+				"utilization":        types.NumberType,
+				"free_blocks":        types.ListType{ElemType: types.StringType},
+				"largest_free_block": types.StringType,
+				"fragmentation":      types.NumberType,
+			},
+		},
+	}
+}
+
+// Run executes the CIDR utilization function.
+func (f CIDRUtilizationFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var parent string
+	var allocated []string
+
+	// Parse the arguments
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &parent, &allocated))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input arguments
+	if parent == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The parent argument must be provided and valid"))
+		return
+	}
+
+	// Report the utilization
+	report, err := CIDRUtilization(parent, allocated)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error calculating CIDR utilization: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, report))
+}
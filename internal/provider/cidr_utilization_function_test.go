@@ -0,0 +1,114 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestCIDRUtilizationFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		result    string
+	}{
+		"half-used": {
+			arguments: `"10.0.0.0/24", ["10.0.0.0/26", "10.0.0.128/26"]`,
+			result:    `{"fragmentation":0.5,"free_addresses":128,"free_blocks":["10.0.0.64/26","10.0.0.192/26"],"largest_free_block":"10.0.0.64/26","total_addresses":256,"used_addresses":128,"utilization":50}`,
+		},
+		"empty": {
+			arguments: `"10.0.0.0/16", []`,
+			result:    `{"fragmentation":0,"free_addresses":65536,"free_blocks":["10.0.0.0/16"],"largest_free_block":"10.0.0.0/16","total_addresses":65536,"used_addresses":0,"utilization":0}`,
+		},
+		"full": {
+			arguments: `"10.0.0.0/24", ["10.0.0.128/25", "10.0.0.0/25"]`,
+			result:    `{"fragmentation":0,"free_addresses":0,"free_blocks":[],"largest_free_block":null,"total_addresses":256,"used_addresses":256,"utilization":100}`,
+		},
+		"fragmented": {
+			arguments: `"10.0.0.0/24", ["10.0.0.4/30"]`,
+			result:    `{"fragmentation":0.4921,"free_addresses":252,"free_blocks":["10.0.0.0/30","10.0.0.8/29","10.0.0.16/28","10.0.0.32/27","10.0.0.64/26","10.0.0.128/25"],"largest_free_block":"10.0.0.128/25","total_addresses":256,"used_addresses":4,"utilization":1.56}`,
+		},
+		"ipv6": {
+			arguments: `"2001:db8::/48", ["2001:db8::/64"]`,
+			result:    `{"fragmentation":0.5,"free_addresses":1208907372870555465154560,"free_blocks":["2001:db8:0:1::/64","2001:db8:0:2::/63","2001:db8:0:4::/62","2001:db8:0:8::/61","2001:db8:0:10::/60","2001:db8:0:20::/59","2001:db8:0:40::/58","2001:db8:0:80::/57","2001:db8:0:100::/56","2001:db8:0:200::/55","2001:db8:0:400::/54","2001:db8:0:800::/53","2001:db8:0:1000::/52","2001:db8:0:2000::/51","2001:db8:0:4000::/50","2001:db8:0:8000::/49"],"largest_free_block":"2001:db8:0:8000::/49","total_addresses":1208925819614629174706176,"used_addresses":18446744073709551616,"utilization":0}`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::cidr_utilization(%s))
+							}
+						`, testCase.arguments),
+						Check: resource.TestCheckOutput("result", testCase.result),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestCIDRUtilizationFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		error     string
+	}{
+		"empty-parent": {
+			arguments: `"", []`,
+			error:     `(?s)Call to function "provider::iactools::cidr_utilization" failed.*The\s+parent\s+argument\s+must\s+be\s+provided\s+and\s+valid`,
+		},
+		"invalid-parent": {
+			arguments: `"10.0.0.0/33", []`,
+			error:     `(?s)Call to function "provider::iactools::cidr_utilization" failed.*Error\s+calculating\s+CIDR\s+utilization:\s+invalid\s+parent\s+CIDR`,
+		},
+		"invalid-allocated": {
+			arguments: `"10.0.0.0/16", ["10.0.0.0"]`,
+			error:     `(?s)Call to function "provider::iactools::cidr_utilization" failed.*Error\s+calculating\s+CIDR\s+utilization:\s+invalid\s+allocated\s+CIDR`,
+		},
+		"outside-parent": {
+			arguments: `"10.0.0.0/16", ["10.1.0.0/24"]`,
+			error:     `(?s)Call to function "provider::iactools::cidr_utilization" failed.*allocated\s+CIDR\s+10.1.0.0/24\s+is\s+not\s+within\s+parent\s+CIDR\s+10.0.0.0/16`,
+		},
+		"larger-than-parent": {
+			arguments: `"10.0.0.0/16", ["10.0.0.0/8"]`,
+			error:     `(?s)Call to function "provider::iactools::cidr_utilization" failed.*allocated\s+CIDR\s+10.0.0.0/8\s+is\s+not\s+within\s+parent\s+CIDR\s+10.0.0.0/16`,
+		},
+		"overlap": {
+			arguments: `"10.0.0.0/16", ["10.0.0.0/24", "10.0.1.0/24", "10.0.0.128/25"]`,
+			error:     `(?s)Call to function "provider::iactools::cidr_utilization" failed.*allocated\s+CIDRs\s+10.0.0.0/24\s+and\s+10.0.0.128/25\s+overlap`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::cidr_utilization(%s)
+							}
+						`, testCase.arguments),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+)
+
+// Platforms of the published IP range documents.
+const (
+	cloudPlatformAzure = "azure"
+	cloudPlatformAWS   = "aws"
+	cloudPlatformGCP   = "gcp"
+)
+
+// cloudIPRangeDocument is the union of the Azure ServiceTags, AWS ip-ranges.json and GCP cloud.json document structures.
+type cloudIPRangeDocument struct {
+	// Azure ServiceTags
+	Values []struct {
+		Name       string `json:"name"`
+		Properties struct {
+			Region          string   `json:"region"`
+			AddressPrefixes []string `json:"addressPrefixes"`
+		} `json:"properties"`
+	} `json:"values"`
+
+	// AWS ip-ranges.json and GCP cloud.json
+	Prefixes []struct {
+		IPPrefix   string `json:"ip_prefix"`
+		IPv4Prefix string `json:"ipv4Prefix"`
+		IPv6Prefix string `json:"ipv6Prefix"`
+		Service    string `json:"service"`
+		Region     string `json:"region"`
+		Scope      string `json:"scope"`
+	} `json:"prefixes"`
+	IPv6Prefixes []struct {
+		IPv6Prefix string `json:"ipv6_prefix"`
+		Service    string `json:"service"`
+		Region     string `json:"region"`
+	} `json:"ipv6_prefixes"`
+}
+
+// CloudIPRangeFilters holds the filters of the cloud IP ranges, empty filters match every prefix.
+type CloudIPRangeFilters struct {
+	Services  []string
+	Regions   []string
+	Family    string
+	Summarize bool
+}
+
+// cloudIPRange is a prefix of a published IP range document with its service and region.
+type cloudIPRange struct {
+	prefix  string
+	service string
+	region  string
+}
+
+// CloudIPRanges parses an Azure ServiceTags, AWS ip-ranges.json or GCP cloud.json document and returns the prefixes matching the filters,
+// sorted and without duplicates, or merged into the fewest CIDRs when they are summarized.
+// The platform is detected from the document when it is empty.
+func CloudIPRanges(document, platform string, filters CloudIPRangeFilters) ([]string, error) {
+	var parsed cloudIPRangeDocument
+	if err := json.Unmarshal([]byte(document), &parsed); err != nil {
+		return nil, fmt.Errorf("invalid JSON document: %v", err)
+	}
+
+	platform = strings.ToLower(platform)
+	if platform == "" {
+		platform = detectCloudPlatform(&parsed)
+		if platform == "" {
+			return nil, fmt.Errorf("unknown document format, must be an Azure ServiceTags, AWS ip-ranges.json or GCP cloud.json document")
+		}
+	}
+
+	var ranges []cloudIPRange
+	switch platform {
+	case cloudPlatformAzure:
+		for _, value := range parsed.Values {
+			for _, prefix := range value.Properties.AddressPrefixes {
+				ranges = append(ranges, cloudIPRange{prefix: prefix, service: value.Name, region: value.Properties.Region})
+			}
+		}
+	case cloudPlatformAWS:
+		for _, prefix := range parsed.Prefixes {
+			ranges = append(ranges, cloudIPRange{prefix: prefix.IPPrefix, service: prefix.Service, region: prefix.Region})
+		}
+		for _, prefix := range parsed.IPv6Prefixes {
+			ranges = append(ranges, cloudIPRange{prefix: prefix.IPv6Prefix, service: prefix.Service, region: prefix.Region})
+		}
+	case cloudPlatformGCP:
+		for _, prefix := range parsed.Prefixes {
+			ranges = append(ranges, cloudIPRange{prefix: prefix.IPv4Prefix + prefix.IPv6Prefix, service: prefix.Service, region: prefix.Scope})
+		}
+	default:
+		return nil, fmt.Errorf("unknown platform %q, must be one of %s, %s or %s", platform, cloudPlatformAzure, cloudPlatformAWS, cloudPlatformGCP)
+	}
+	if len(ranges) == 0 {
+		return nil, fmt.Errorf("the document has no %s IP ranges", platform)
+	}
+
+	var length int
+	switch strings.ToLower(filters.Family) {
+	case "":
+	case addressFamilyIPv4:
+		length = net.IPv4len
+	case addressFamilyIPv6:
+		length = net.IPv6len
+	default:
+		return nil, fmt.Errorf("unknown address family %q, must be %s or %s", filters.Family, addressFamilyIPv4, addressFamilyIPv6)
+	}
+
+	ipnets := make([]*net.IPNet, 0, len(ranges))
+	for _, r := range ranges {
+		if !matchesCloudIPRangeFilter(r.service, filters.Services) || !matchesCloudIPRangeFilter(r.region, filters.Regions) {
+			continue
+		}
+		_, ipnet, err := net.ParseCIDR(r.prefix)
+		if err != nil {
+			return nil, fmt.Errorf("invalid prefix %q of service %q: %v", r.prefix, r.service, err)
+		}
+		if length == 0 || len(ipnet.IP) == length {
+			ipnets = append(ipnets, ipnet)
+		}
+	}
+
+	if filters.Summarize {
+		ipnets = aggregateCIDRs(ipnets)
+	} else {
+		sortIPNets(ipnets)
+		ipnets = slices.CompactFunc(ipnets, func(a, b *net.IPNet) bool {
+			return compareIPNets(a, b) == 0
+		})
+	}
+
+	prefixes := make([]string, 0, len(ipnets))
+	for _, ipnet := range ipnets {
+		prefixes = append(prefixes, ipnet.String())
+	}
+	return prefixes, nil
+}
+
+// Helper functions
+
+// detectCloudPlatform detects the platform of a published IP range document from its structure.
+func detectCloudPlatform(document *cloudIPRangeDocument) string {
+	if len(document.Values) > 0 {
+		return cloudPlatformAzure
+	}
+	if len(document.IPv6Prefixes) > 0 {
+		return cloudPlatformAWS
+	}
+	for _, prefix := range document.Prefixes {
+		if prefix.IPPrefix != "" {
+			return cloudPlatformAWS
+		}
+		if prefix.IPv4Prefix != "" || prefix.IPv6Prefix != "" {
+			return cloudPlatformGCP
+		}
+	}
+	return ""
+}
+
+// matchesCloudIPRangeFilter reports whether a service or region matches one of the filter values, ignoring case.
+func matchesCloudIPRangeFilter(value string, filter []string) bool {
+	if len(filter) == 0 {
+		return true
+	}
+	return slices.ContainsFunc(filter, func(candidate string) bool {
+		return strings.EqualFold(candidate, value)
+	})
+}
@@ -0,0 +1,137 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = CloudIPRangesFunction{}
+)
+
+// NewCloudIPRangesFunction is a helper function to create a new instance of CloudIPRangesFunction.
+func NewCloudIPRangesFunction() function.Function {
+	return CloudIPRangesFunction{}
+}
+
+// CloudIPRangesFunction is the struct for the cloud IP ranges function.
+type CloudIPRangesFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f CloudIPRangesFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cloud_ip_ranges"
+}
+
+// Definition sets the definition for the function.
+func (f CloudIPRangesFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Filter the published IP ranges of Azure, AWS and GCP",
+		MarkdownDescription: "Parses an Azure ServiceTags, AWS `ip-ranges.json` or GCP `cloud.json` document and outputs the prefixes matching the filters, " +
+			"sorted with IPv4 before IPv6 and without duplicates. " +
+			"The `service` filter matches the service tag name like `AzureCloud.westeurope` or `Storage` for Azure, the service like `EC2` or `AMAZON` for AWS, and the service like `Google Cloud` for GCP. " +
+			"The `region` filter matches the region like `westeurope` for Azure and `eu-west-1` for AWS, and the scope like `europe-west1` for GCP. " +
+			"Both accept a string or a list of strings and ignore case. " +
+			"The `family` filter keeps only `ipv4` or `ipv6` prefixes, and `summarize` merges the prefixes into the fewest CIDRs covering the same addresses, " +
+			"to fit the prefixes under the rule limits of firewalls and security groups. " +
+			"The document must be read from a file in the configuration, e.g. with `file()`; the function never downloads the published documents.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "document",
+				MarkdownDescription: "The JSON document with the published IP ranges",
+			},
+			function.StringParameter{
+				Name:                "platform",
+				MarkdownDescription: "The platform of the document, `azure`, `aws` or `gcp`, or null to detect it from the document",
+				AllowNullValue:      true,
+			},
+			function.DynamicParameter{
+				Name:                "filters",
+				MarkdownDescription: "An object with the optional attributes `service`, `region`, `family` and `summarize`, or null to output every prefix",
+				AllowNullValue:      true,
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+// Run executes the cloud IP ranges function.
+func (f CloudIPRangesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var document string
+	var platform *string
+	var filtersArgument types.Dynamic
+
+	// Parse the arguments
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &document, &platform, &filtersArgument))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input arguments
+	if document == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The document argument must be provided and valid"))
+		return
+	}
+	filters, err := parseCloudIPRangeFilters(filtersArgument)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error parsing filters: %s", err.Error())))
+		return
+	}
+
+	// Filter the IP ranges
+	prefixes, err := CloudIPRanges(document, stringValueOrEmpty(platform), filters)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error parsing IP ranges: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, prefixes))
+}
+
+// parseCloudIPRangeFilters converts the filters argument into cloud IP range filters.
+func parseCloudIPRangeFilters(value types.Dynamic) (CloudIPRangeFilters, error) {
+	var filters CloudIPRangeFilters
+	converted, err := dynamicToGo(value)
+	if err != nil || converted == nil {
+		return filters, err
+	}
+	object, err := goObject(converted, "filters")
+	if err != nil {
+		return filters, err
+	}
+	if err := checkObjectKeys(object, "filters", "service", "region", "family", "summarize"); err != nil {
+		return filters, err
+	}
+
+	if object["service"] != nil {
+		if filters.Services, err = goStringList(object["service"], "filters.service"); err != nil {
+			return filters, err
+		}
+	}
+	if object["region"] != nil {
+		if filters.Regions, err = goStringList(object["region"], "filters.region"); err != nil {
+			return filters, err
+		}
+	}
+	if object["family"] != nil {
+		if filters.Family, err = goString(object["family"], "filters.family"); err != nil {
+			return filters, err
+		}
+	}
+	if object["summarize"] != nil {
+		if filters.Summarize, err = goBool(object["summarize"], "filters.summarize"); err != nil {
+			return filters, err
+		}
+	}
+	return filters, nil
+}
@@ -0,0 +1,134 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestCloudIPRangesFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		result    string
+	}{
+		"azure-service-ipv4-summarized": {
+			arguments: `jsonencode({ changeNumber = 1, cloud = "Public", values = [{ name = "AzureCloud.westeurope", properties = { region = "westeurope", addressPrefixes = ["20.50.0.0/18", "20.50.64.0/18", "2603:1020:200::/46", "13.69.0.0/17"] } }, { name = "AzureCloud.northeurope", properties = { region = "northeurope", addressPrefixes = ["13.69.128.0/17", "20.50.0.0/18"] } }, { name = "Storage", properties = { region = "", addressPrefixes = ["20.60.0.0/16"] } }] }), "azure", { service = "AzureCloud.westeurope", family = "ipv4", summarize = true }`,
+			result:    `["13.69.0.0/17","20.50.0.0/17"]`,
+		},
+		"azure-detected-unfiltered": {
+			arguments: `jsonencode({ changeNumber = 1, cloud = "Public", values = [{ name = "AzureCloud.westeurope", properties = { region = "westeurope", addressPrefixes = ["20.50.0.0/18", "20.50.64.0/18", "2603:1020:200::/46", "13.69.0.0/17"] } }, { name = "AzureCloud.northeurope", properties = { region = "northeurope", addressPrefixes = ["13.69.128.0/17", "20.50.0.0/18"] } }, { name = "Storage", properties = { region = "", addressPrefixes = ["20.60.0.0/16"] } }] }), null, null`,
+			result:    `["13.69.0.0/17","13.69.128.0/17","20.50.0.0/18","20.50.64.0/18","20.60.0.0/16","2603:1020:200::/46"]`,
+		},
+		"azure-regions-summarized": {
+			arguments: `jsonencode({ changeNumber = 1, cloud = "Public", values = [{ name = "AzureCloud.westeurope", properties = { region = "westeurope", addressPrefixes = ["20.50.0.0/18", "20.50.64.0/18", "2603:1020:200::/46", "13.69.0.0/17"] } }, { name = "AzureCloud.northeurope", properties = { region = "northeurope", addressPrefixes = ["13.69.128.0/17", "20.50.0.0/18"] } }, { name = "Storage", properties = { region = "", addressPrefixes = ["20.60.0.0/16"] } }] }), "azure", { region = ["westeurope", "northeurope"], summarize = true }`,
+			result:    `["13.69.0.0/16","20.50.0.0/17","2603:1020:200::/46"]`,
+		},
+		"aws-region": {
+			arguments: `jsonencode({ syncToken = "1", prefixes = [{ ip_prefix = "3.5.140.0/22", region = "ap-northeast-2", service = "AMAZON" }, { ip_prefix = "52.94.76.0/22", region = "eu-west-1", service = "EC2" }, { ip_prefix = "52.94.72.0/22", region = "eu-west-1", service = "EC2" }], ipv6_prefixes = [{ ipv6_prefix = "2a05:d07a:a000::/40", region = "eu-west-1", service = "S3" }] }), "aws", { region = "EU-WEST-1" }`,
+			result:    `["52.94.72.0/22","52.94.76.0/22","2a05:d07a:a000::/40"]`,
+		},
+		"aws-service-summarized": {
+			arguments: `jsonencode({ syncToken = "1", prefixes = [{ ip_prefix = "3.5.140.0/22", region = "ap-northeast-2", service = "AMAZON" }, { ip_prefix = "52.94.76.0/22", region = "eu-west-1", service = "EC2" }, { ip_prefix = "52.94.72.0/22", region = "eu-west-1", service = "EC2" }], ipv6_prefixes = [{ ipv6_prefix = "2a05:d07a:a000::/40", region = "eu-west-1", service = "S3" }] }), null, { service = "ec2", summarize = true }`,
+			result:    `["52.94.72.0/21"]`,
+		},
+		"gcp-scope-ipv6": {
+			arguments: `jsonencode({ syncToken = "1", prefixes = [{ ipv4Prefix = "34.1.208.0/20", service = "Google Cloud", scope = "africa-south1" }, { ipv6Prefix = "2600:1900:8000::/44", service = "Google Cloud", scope = "africa-south1" }, { ipv4Prefix = "34.22.0.0/19", service = "Google Cloud", scope = "europe-west1" }] }), null, { region = "africa-south1", family = "ipv6" }`,
+			result:    `["2600:1900:8000::/44"]`,
+		},
+		"no-match": {
+			arguments: `jsonencode({ syncToken = "1", prefixes = [{ ipv4Prefix = "34.1.208.0/20", service = "Google Cloud", scope = "africa-south1" }, { ipv6Prefix = "2600:1900:8000::/44", service = "Google Cloud", scope = "africa-south1" }, { ipv4Prefix = "34.22.0.0/19", service = "Google Cloud", scope = "europe-west1" }] }), "gcp", { service = "Google Cloud", region = "us-east1" }`,
+			result:    `[]`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::cloud_ip_ranges(%s))
+							}
+						`, testCase.arguments),
+						Check: resource.TestCheckOutput("result", testCase.result),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestCloudIPRangesFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		error     string
+	}{
+		"empty": {
+			arguments: `"", null, null`,
+			error:     `(?s)Call to function "provider::iactools::cloud_ip_ranges" failed.*The\s+document\s+argument\s+must\s+be\s+provided\s+and\s+valid`,
+		},
+		"invalid-json": {
+			arguments: `"not json", null, null`,
+			error:     `(?s)Call to function "provider::iactools::cloud_ip_ranges" failed.*Error\s+parsing\s+IP\s+ranges:\s+invalid\s+JSON\s+document`,
+		},
+		"unknown-format": {
+			arguments: `jsonencode({ foo = 1 }), null, null`,
+			error:     `(?s)Call to function "provider::iactools::cloud_ip_ranges" failed.*unknown\s+document\s+format,\s+must\s+be\s+an\s+Azure\s+ServiceTags,\s+AWS\s+ip-ranges.json\s+or\s+GCP\s+cloud.json\s+document`,
+		},
+		"unknown-platform": {
+			arguments: `jsonencode({ changeNumber = 1, cloud = "Public", values = [{ name = "AzureCloud.westeurope", properties = { region = "westeurope", addressPrefixes = ["20.50.0.0/18", "20.50.64.0/18", "2603:1020:200::/46", "13.69.0.0/17"] } }, { name = "AzureCloud.northeurope", properties = { region = "northeurope", addressPrefixes = ["13.69.128.0/17", "20.50.0.0/18"] } }, { name = "Storage", properties = { region = "", addressPrefixes = ["20.60.0.0/16"] } }] }), "oracle", null`,
+			error:     `(?s)Call to function "provider::iactools::cloud_ip_ranges" failed.*unknown\s+platform\s+"oracle",\s+must\s+be\s+one\s+of\s+azure,\s+aws\s+or\s+gcp`,
+		},
+		"wrong-platform": {
+			arguments: `jsonencode({ changeNumber = 1, cloud = "Public", values = [{ name = "AzureCloud.westeurope", properties = { region = "westeurope", addressPrefixes = ["20.50.0.0/18", "20.50.64.0/18", "2603:1020:200::/46", "13.69.0.0/17"] } }, { name = "AzureCloud.northeurope", properties = { region = "northeurope", addressPrefixes = ["13.69.128.0/17", "20.50.0.0/18"] } }, { name = "Storage", properties = { region = "", addressPrefixes = ["20.60.0.0/16"] } }] }), "aws", null`,
+			error:     `(?s)Call to function "provider::iactools::cloud_ip_ranges" failed.*the\s+document\s+has\s+no\s+aws\s+IP\s+ranges`,
+		},
+		"invalid-prefix": {
+			arguments: `jsonencode({ prefixes = [{ ip_prefix = "3.5.140.0/33", service = "AMAZON", region = "GLOBAL" }] }), null, null`,
+			error:     `(?s)Call to function "provider::iactools::cloud_ip_ranges" failed.*invalid\s+prefix\s+"3.5.140.0/33"\s+of\s+service\s+"AMAZON"`,
+		},
+		"unknown-family": {
+			arguments: `jsonencode({ syncToken = "1", prefixes = [{ ipv4Prefix = "34.1.208.0/20", service = "Google Cloud", scope = "africa-south1" }, { ipv6Prefix = "2600:1900:8000::/44", service = "Google Cloud", scope = "africa-south1" }, { ipv4Prefix = "34.22.0.0/19", service = "Google Cloud", scope = "europe-west1" }] }), null, { family = "ipv5" }`,
+			error:     `(?s)Call to function "provider::iactools::cloud_ip_ranges" failed.*unknown\s+address\s+family\s+"ipv5",\s+must\s+be\s+ipv4\s+or\s+ipv6`,
+		},
+		"unsupported-filter": {
+			arguments: `jsonencode({ syncToken = "1", prefixes = [{ ipv4Prefix = "34.1.208.0/20", service = "Google Cloud", scope = "africa-south1" }, { ipv6Prefix = "2600:1900:8000::/44", service = "Google Cloud", scope = "africa-south1" }, { ipv4Prefix = "34.22.0.0/19", service = "Google Cloud", scope = "europe-west1" }] }), null, { scope = "europe-west1" }`,
+			error:     `(?s)Call to function "provider::iactools::cloud_ip_ranges" failed.*Error\s+parsing\s+filters:\s+filters\s+has\s+unsupported\s+attributes:\s+\[scope\]`,
+		},
+		"filters-not-an-object": {
+			arguments: `jsonencode({ syncToken = "1", prefixes = [{ ipv4Prefix = "34.1.208.0/20", service = "Google Cloud", scope = "africa-south1" }, { ipv6Prefix = "2600:1900:8000::/44", service = "Google Cloud", scope = "africa-south1" }, { ipv4Prefix = "34.22.0.0/19", service = "Google Cloud", scope = "europe-west1" }] }), null, "europe-west1"`,
+			error:     `(?s)Call to function "provider::iactools::cloud_ip_ranges" failed.*Error\s+parsing\s+filters:\s+filters\s+must\s+be\s+an\s+object`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::cloud_ip_ranges(%s)
+							}
+						`, testCase.arguments),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// dkimChunkSize is the maximum length of a single character-string in a TXT record.
+const dkimChunkSize = 255
+
+// dkimSelectorRegex matches a DKIM selector, which is one or more dot separated DNS labels.
+var dkimSelectorRegex = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?(\.[A-Za-z0-9]([A-Za-z0-9-]{0,61}[A-Za-z0-9])?)*$`)
+
+// DKIMRecordResult holds the owner name and the TXT record value of a DKIM public key.
+type DKIMRecordResult struct {
+	Name    string   `tfsdk:"name"`
+	Value   string   `tfsdk:"value"`
+	Records []string `tfsdk:"records"`
+}
+
+// DKIMRecord generates the DKIM TXT record for a PEM encoded public key.
+func DKIMRecord(publicKeyPEM string, options map[string]string) (*DKIMRecordResult, error) {
+	var unknown []string
+	for option := range options {
+		if !slices.Contains([]string{"selector", "domain", "hash_algorithms", "flags", "service_type", "notes"}, option) {
+			unknown = append(unknown, option)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("unknown DKIM options: %s", strings.Join(unknown, ", "))
+	}
+
+	selector := strings.TrimSuffix(options["selector"], ".")
+	if !dkimSelectorRegex.MatchString(selector) {
+		return nil, fmt.Errorf("invalid DKIM selector %q", options["selector"])
+	}
+
+	keyType, publicKey, err := parseDKIMPublicKey(publicKeyPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := []string{"v=DKIM1"}
+	if hashAlgorithms, ok := options["hash_algorithms"]; ok {
+		if err := validateDKIMList("hash_algorithms", hashAlgorithms, "sha1", "sha256"); err != nil {
+			return nil, err
+		}
+		tags = append(tags, "h="+hashAlgorithms)
+	}
+	tags = append(tags, "k="+keyType)
+	if notes, ok := options["notes"]; ok {
+		if strings.ContainsAny(notes, ";\"") {
+			return nil, fmt.Errorf("DKIM notes must not contain semicolons or double quotes")
+		}
+		tags = append(tags, "n="+notes)
+	}
+	if serviceType, ok := options["service_type"]; ok {
+		if err := validateDKIMList("service_type", serviceType, "*", "email"); err != nil {
+			return nil, err
+		}
+		tags = append(tags, "s="+serviceType)
+	}
+	if flags, ok := options["flags"]; ok {
+		if err := validateDKIMList("flags", flags, "y", "s"); err != nil {
+			return nil, err
+		}
+		tags = append(tags, "t="+flags)
+	}
+	tags = append(tags, "p="+publicKey)
+
+	name := selector + "._domainkey"
+	if domain := strings.TrimSuffix(options["domain"], "."); domain != "" {
+		name = name + "." + domain
+	}
+
+	value := strings.Join(tags, "; ")
+
+	return &DKIMRecordResult{
+		Name:    name,
+		Value:   value,
+		Records: chunkString(value, dkimChunkSize),
+	}, nil
+}
+
+// Helper functions
+
+// parseDKIMPublicKey decodes a PEM public key and returns its DKIM key type and base64 encoded key data.
+func parseDKIMPublicKey(publicKeyPEM string) (string, string, error) {
+	block, _ := pem.Decode([]byte(strings.TrimSpace(publicKeyPEM)))
+	if block == nil {
+		return "", "", fmt.Errorf("cannot decode PEM public key")
+	}
+
+	var publicKey any
+	var err error
+	switch block.Type {
+	case "PUBLIC KEY":
+		publicKey, err = x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		publicKey, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	default:
+		return "", "", fmt.Errorf("unsupported PEM block type %q, expected a PUBLIC KEY", block.Type)
+	}
+	if err != nil {
+		return "", "", fmt.Errorf("cannot parse public key: %v", err)
+	}
+
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		if key.N.BitLen() < 1024 {
+			return "", "", fmt.Errorf("RSA key size %d is too small, DKIM requires at least 1024 bits", key.N.BitLen())
+		}
+		der, err := x509.MarshalPKIXPublicKey(key)
+		if err != nil {
+			return "", "", fmt.Errorf("cannot encode public key: %v", err)
+		}
+		return "rsa", base64.StdEncoding.EncodeToString(der), nil
+	case ed25519.PublicKey:
+		// RFC 8463 publishes the raw 32 byte Ed25519 key instead of the SubjectPublicKeyInfo
+		return "ed25519", base64.StdEncoding.EncodeToString(key), nil
+	default:
+		return "", "", fmt.Errorf("unsupported public key type %T, only RSA and Ed25519 keys are supported", publicKey)
+	}
+}
+
+// validateDKIMList checks a colon separated list of DKIM tag values against the allowed values.
+func validateDKIMList(option, value string, allowed ...string) error {
+	for _, item := range strings.Split(value, ":") {
+		if !slices.Contains(allowed, item) {
+			return fmt.Errorf("invalid value %q for DKIM option %s: must be a colon separated list of %s", value, option, strings.Join(allowed, ", "))
+		}
+	}
+	return nil
+}
+
+// chunkString splits a string into chunks of at most size bytes.
+func chunkString(value string, size int) []string {
+	chunks := make([]string, 0, len(value)/size+1)
+	for len(value) > size {
+		chunks = append(chunks, value[:size])
+		value = value[size:]
+	}
+	return append(chunks, value)
+}
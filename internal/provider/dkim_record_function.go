@@ -0,0 +1,93 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = DKIMRecordFunction{}
+)
+
+// NewDKIMRecordFunction is a helper function to create a new instance of DKIMRecordFunction.
+func NewDKIMRecordFunction() function.Function {
+	return DKIMRecordFunction{}
+}
+
+// DKIMRecordFunction is the struct for the DKIM record function.
+type DKIMRecordFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f DKIMRecordFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "dkim_record"
+}
+
+// Definition sets the definition for the function.
+func (f DKIMRecordFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Generate the DKIM TXT record of a public key",
+		MarkdownDescription: "Accepts RSA and Ed25519 public keys in PEM format and outputs an object with the selector owner `name`, " +
+			"the `v=DKIM1; k=rsa; p=...` record `value` and the value split into 255 character `records` for DNS.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "public_key_pem",
+				MarkdownDescription: "The PEM encoded public key of the DKIM key pair",
+			},
+			function.MapParameter{
+				Name: "options",
+				MarkdownDescription: "The record options: `selector` (required), `domain`, `hash_algorithms` (`h`), " +
+					"`flags` (`t`), `service_type` (`s`) and `notes` (`n`)",
+				ElementType: types.StringType,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"name":    types.StringType,
+				"value":   types.StringType,
+				"records": types.ListType{ElemType: types.StringType},
+			},
+		},
+	}
+}
+
+// Run executes the DKIM record function.
+func (f DKIMRecordFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var publicKeyPEM string
+	var options map[string]string
+
+	// Parse the arguments
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &publicKeyPEM, &options))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input arguments
+	if strings.TrimSpace(publicKeyPEM) == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The public_key_pem argument must be provided and valid"))
+		return
+	}
+	if options["selector"] == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The selector option must be provided and valid"))
+		return
+	}
+
+	// Generate the DKIM record
+	record, err := DKIMRecord(publicKeyPEM, options)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error generating DKIM record: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, record))
+}
@@ -0,0 +1,175 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+const testDKIMRSAPublicKey = `-----BEGIN PUBLIC KEY-----
+MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAxi5nh6HIHwfRyIINPHEo
+WkpfVoDGLtrafIufZB0MhMyL4naEx3ZTHGAFGwjzZSFpK71rj+sJgrIQf/6NEQ5I
+aEpUD3wXyXOggioUZrmUdsc6174pM1PdQ935lGrdDFXYfM6AR/69nZGHDl8aU0WH
+RSg3OL7xNcwyTeNyUTyLUoWd1lkP5ZLazAEqYHAGQFZSnimcAl4eIY0hR9SEofNg
+iq7SQGZ+mxLVUnrVcib1f/UbXS8MmNVWZJKqNQdmyMO9GJd9/DbCpKUZgpRIAbYh
+21LRqh1yr7WgeSitPiNqs7xp1qCktvVUMuTussan23N7ybYyjvLrtZK93evDLKnr
+KwIDAQAB
+-----END PUBLIC KEY-----`
+
+const testDKIMEd25519PublicKey = `-----BEGIN PUBLIC KEY-----
+MCowBQYDK2VwAyEALq1NpQ41iYROIby13dVBqZfSkM+Dpb0/lnnVYXrPFVw=
+-----END PUBLIC KEY-----`
+
+const testDKIMSmallRSAPublicKey = `-----BEGIN PUBLIC KEY-----
+MFwwDQYJKoZIhvcNAQEBBQADSwAwSAJBAJHepcakgliR9MWFwYzLNBfdUZmO7/QH
+TVHNT23zXkwyzOSLD3Fl76D8ytBrAgyojkHvLr9PC8C+uwdoxJzBY78CAwEAAQ==
+-----END PUBLIC KEY-----`
+
+func TestDKIMRecordFunction_Valid(t *testing.T) {
+	rsaKeyData := strings.Join(strings.Split(testDKIMRSAPublicKey, "\n")[1:8], "")
+
+	testCases := map[string]struct {
+		publicKey string
+		options   string
+		name      string
+		value     string
+		chunks    string
+	}{
+		"rsa-with-domain": {
+			publicKey: testDKIMRSAPublicKey,
+			options:   `{ selector = "s1", domain = "example.com" }`,
+			name:      "s1._domainkey.example.com",
+			value:     "v=DKIM1; k=rsa; p=" + rsaKeyData,
+			chunks:    "2",
+		},
+		"rsa-with-tags": {
+			publicKey: testDKIMRSAPublicKey,
+			options:   `{ selector = "mail.2024", hash_algorithms = "sha256", flags = "s", service_type = "email" }`,
+			name:      "mail.2024._domainkey",
+			value:     "v=DKIM1; h=sha256; k=rsa; s=email; t=s; p=" + rsaKeyData,
+			chunks:    "2",
+		},
+		"ed25519": {
+			publicKey: testDKIMEd25519PublicKey,
+			options:   `{ selector = "ed", domain = "example.com." }`,
+			name:      "ed._domainkey.example.com",
+			value:     "v=DKIM1; k=ed25519; p=Lq1NpQ41iYROIby13dVBqZfSkM+Dpb0/lnnVYXrPFVw=",
+			chunks:    "1",
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							locals {
+								record = provider::iactools::dkim_record(<<-EOT
+								%s
+								EOT
+								, %s)
+							}
+							output "name" {
+								value = local.record.name
+							}
+							output "value" {
+								value = local.record.value
+							}
+							output "chunks" {
+								value = length(local.record.records)
+							}
+							output "joined" {
+								value = join("", local.record.records)
+							}
+						`, testCase.publicKey, testCase.options),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckOutput("name", testCase.name),
+							resource.TestCheckOutput("value", testCase.value),
+							resource.TestCheckOutput("chunks", testCase.chunks),
+							resource.TestCheckOutput("joined", testCase.value),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestDKIMRecordFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		publicKey string
+		options   string
+		error     string
+	}{
+		"empty-public-key": {
+			publicKey: "",
+			options:   `{ selector = "s1" }`,
+			error:     `(?s)Call to function "provider::iactools::dkim_record" failed.*The public_key_pem.*argument must be provided and valid`,
+		},
+		"missing-selector": {
+			publicKey: testDKIMRSAPublicKey,
+			options:   `{ domain = "example.com" }`,
+			error:     `(?s)Call to function "provider::iactools::dkim_record" failed.*The selector.*option must be provided and valid`,
+		},
+		"invalid-pem": {
+			publicKey: "not a key",
+			options:   `{ selector = "s1" }`,
+			error:     `(?s)Call to function "provider::iactools::dkim_record" failed.*Error.*generating.*cannot decode PEM public key`,
+		},
+		"small-rsa-key": {
+			publicKey: testDKIMSmallRSAPublicKey,
+			options:   `{ selector = "s1" }`,
+			error:     `(?s)Call to function "provider::iactools::dkim_record" failed.*RSA key size 512 is too small`,
+		},
+		"unknown-option": {
+			publicKey: testDKIMRSAPublicKey,
+			options:   `{ selector = "s1", key_size = "2048" }`,
+			error:     `(?s)Call to function "provider::iactools::dkim_record" failed.*unknown DKIM options:.*key_size`,
+		},
+		"invalid-selector": {
+			publicKey: testDKIMRSAPublicKey,
+			options:   `{ selector = "bad_selector" }`,
+			error:     `(?s)Call to function "provider::iactools::dkim_record" failed.*invalid DKIM selector.*"bad_selector"`,
+		},
+		"invalid-hash-algorithm": {
+			publicKey: testDKIMRSAPublicKey,
+			options:   `{ selector = "s1", hash_algorithms = "md5" }`,
+			error:     `(?s)Call to function "provider::iactools::dkim_record" failed.*invalid value "md5" for DKIM.*option hash_algorithms`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::dkim_record(<<-EOT
+								%s
+								EOT
+								, %s)
+							}
+						`, testCase.publicKey, testCase.options),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/mail"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// dmarcTagOrder is the canonical order of the DMARC tags, as defined in RFC 7489 section 6.3.
+var dmarcTagOrder = []string{"v", "p", "sp", "adkim", "aspf", "pct", "fo", "rf", "ri", "rua", "ruf"}
+
+// dmarcReportSizeRegex matches the optional maximum report size suffix of a DMARC URI.
+var dmarcReportSizeRegex = regexp.MustCompile(`^[0-9]+[kmgt]?$`)
+
+// DMARCBuild validates the DMARC policy tags and renders them as a TXT record value.
+func DMARCBuild(policy map[string]string) (string, error) {
+	tags := make(map[string]string, len(policy))
+	keys := make(map[string]string, len(policy))
+	for key, value := range policy {
+		tag := strings.ToLower(strings.TrimSpace(key))
+		if other, ok := keys[tag]; ok {
+			first, second := min(key, other), max(key, other)
+			return "", fmt.Errorf("DMARC tags %q and %q are the same tag %s", first, second, tag)
+		}
+		keys[tag] = key
+		tags[tag] = strings.TrimSpace(value)
+	}
+
+	if version, ok := tags["v"]; ok && version != "DMARC1" {
+		return "", fmt.Errorf("unsupported DMARC version %q, only DMARC1 is supported", version)
+	}
+	tags["v"] = "DMARC1"
+
+	normalized, err := validateDMARCTags(tags)
+	if err != nil {
+		return "", err
+	}
+
+	parts := make([]string, 0, len(normalized))
+	for _, tag := range dmarcTagOrder {
+		if value, ok := normalized[tag]; ok {
+			parts = append(parts, fmt.Sprintf("%s=%s", tag, value))
+		}
+	}
+
+	return strings.Join(parts, "; "), nil
+}
+
+// DMARCParse parses and validates a DMARC TXT record value into its tags.
+// Tag names are case-insensitive and returned in lowercase.
+func DMARCParse(record string) (map[string]string, error) {
+	tags := make(map[string]string)
+	var order []string
+
+	for _, part := range strings.Split(record, ";") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		tag, value, found := strings.Cut(part, "=")
+		if !found {
+			return nil, fmt.Errorf("malformed DMARC tag %q: expected tag=value", part)
+		}
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		value = strings.TrimSpace(value)
+
+		if _, ok := tags[tag]; ok {
+			return nil, fmt.Errorf("duplicate DMARC tag %q", tag)
+		}
+		tags[tag] = value
+		order = append(order, tag)
+	}
+
+	if len(order) == 0 || order[0] != "v" || tags["v"] != "DMARC1" {
+		return nil, fmt.Errorf("DMARC record must start with v=DMARC1")
+	}
+	if len(order) < 2 || order[1] != "p" {
+		return nil, fmt.Errorf("the p tag must immediately follow the v tag")
+	}
+
+	return validateDMARCTags(tags)
+}
+
+// Helper functions
+
+// validateDMARCTags validates every tag and returns the tags with normalized values.
+func validateDMARCTags(tags map[string]string) (map[string]string, error) {
+	var unknown []string
+	for tag := range tags {
+		if !slices.Contains(dmarcTagOrder, tag) {
+			unknown = append(unknown, tag)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("unknown DMARC tags: %s", strings.Join(unknown, ", "))
+	}
+
+	if _, ok := tags["p"]; !ok {
+		return nil, fmt.Errorf("the p tag is required")
+	}
+
+	normalized := make(map[string]string, len(tags))
+	for tag, value := range tags {
+		var err error
+		switch tag {
+		case "v":
+			normalized[tag] = value
+		case "p", "sp":
+			normalized[tag], err = validateDMARCEnum(tag, value, "none", "quarantine", "reject")
+		case "adkim", "aspf":
+			normalized[tag], err = validateDMARCEnum(tag, value, "r", "s")
+		case "rf":
+			normalized[tag], err = validateDMARCEnum(tag, value, "afrf")
+		case "pct":
+			normalized[tag], err = validateDMARCInteger(tag, value, 0, 100)
+		case "ri":
+			normalized[tag], err = validateDMARCInteger(tag, value, 0, 4294967295)
+		case "fo":
+			normalized[tag], err = validateDMARCFailureOptions(value)
+		case "rua", "ruf":
+			normalized[tag], err = validateDMARCURIs(tag, value)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return normalized, nil
+}
+
+// validateDMARCEnum checks a case-insensitive keyword value against its allowed values.
+func validateDMARCEnum(tag, value string, allowed ...string) (string, error) {
+	lower := strings.ToLower(value)
+	if !slices.Contains(allowed, lower) {
+		return "", fmt.Errorf("invalid value %q for DMARC tag %s: must be one of %s", value, tag, strings.Join(allowed, ", "))
+	}
+	return lower, nil
+}
+
+// validateDMARCInteger checks that a value is an integer within the given range.
+func validateDMARCInteger(tag, value string, lower, upper uint64) (string, error) {
+	number, err := strconv.ParseUint(value, 10, 64)
+	if err != nil || number < lower || number > upper {
+		return "", fmt.Errorf("invalid value %q for DMARC tag %s: must be an integer between %d and %d", value, tag, lower, upper)
+	}
+	return strconv.FormatUint(number, 10), nil
+}
+
+// validateDMARCFailureOptions checks the colon separated failure reporting options.
+func validateDMARCFailureOptions(value string) (string, error) {
+	options := strings.Split(value, ":")
+	for i, option := range options {
+		options[i] = strings.ToLower(strings.TrimSpace(option))
+		if !slices.Contains([]string{"0", "1", "d", "s"}, options[i]) {
+			return "", fmt.Errorf("invalid value %q for DMARC tag fo: options must be 0, 1, d or s separated by colons", value)
+		}
+	}
+	return strings.Join(options, ":"), nil
+}
+
+// validateDMARCURIs checks the comma separated list of mailto reporting URIs.
+func validateDMARCURIs(tag, value string) (string, error) {
+	uris := strings.Split(value, ",")
+	for i, uri := range uris {
+		uri = strings.TrimSpace(uri)
+		address, found := strings.CutPrefix(uri, "mailto:")
+		if !found {
+			return "", fmt.Errorf("invalid URI %q in DMARC tag %s: must start with mailto:", uri, tag)
+		}
+
+		if before, size, ok := strings.Cut(address, "!"); ok {
+			if !dmarcReportSizeRegex.MatchString(size) {
+				return "", fmt.Errorf("invalid report size %q in DMARC tag %s", size, tag)
+			}
+			address = before
+		}
+
+		parsed, err := mail.ParseAddress(address)
+		if err != nil || parsed.Address != address {
+			return "", fmt.Errorf("invalid email address %q in DMARC tag %s", address, tag)
+		}
+		uris[i] = uri
+	}
+	return strings.Join(uris, ","), nil
+}
@@ -0,0 +1,74 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = DMARCBuildFunction{}
+)
+
+// NewDMARCBuildFunction is a helper function to create a new instance of DMARCBuildFunction.
+func NewDMARCBuildFunction() function.Function {
+	return DMARCBuildFunction{}
+}
+
+// DMARCBuildFunction is the struct for the DMARC build function.
+type DMARCBuildFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f DMARCBuildFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "dmarc_build"
+}
+
+// Definition sets the definition for the function.
+func (f DMARCBuildFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Build a DMARC TXT record value from a policy",
+		MarkdownDescription: "Validates the DMARC tags `p`, `sp`, `pct`, `rua`, `ruf`, `adkim`, `aspf`, `fo`, `rf` and `ri`, and outputs the record value with the tags in canonical order.",
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:                "policy",
+				MarkdownDescription: "The DMARC tags and their values, the `p` tag is required. Tag names are case-insensitive, so a tag must not be set twice in different cases",
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run executes the DMARC build function.
+func (f DMARCBuildFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var policy map[string]string
+
+	// Parse the argument
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &policy))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input argument
+	if len(policy) == 0 {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The policy argument must be provided and valid"))
+		return
+	}
+
+	// Build the DMARC record
+	record, err := DMARCBuild(policy)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error building DMARC record: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.StringValue(record)))
+}
@@ -0,0 +1,126 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestDMARCBuildFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		policy string
+		record string
+	}{
+		"reject-only": {
+			policy: `{ p = "reject" }`,
+			record: "v=DMARC1; p=reject",
+		},
+		"canonical-order": {
+			policy: `{ rua = "mailto:dmarc@example.com", pct = 50, p = "Quarantine", sp = "none", adkim = "s", aspf = "r", fo = "1:d" }`,
+			record: "v=DMARC1; p=quarantine; sp=none; adkim=s; aspf=r; pct=50; fo=1:d; rua=mailto:dmarc@example.com",
+		},
+		"uppercase-tags": {
+			policy: `{ P = "reject", PCT = 25 }`,
+			record: "v=DMARC1; p=reject; pct=25",
+		},
+		"multiple-report-uris": {
+			policy: `{ v = "DMARC1", p = "none", rua = "mailto:a@example.com,mailto:b@example.net!10m", ruf = "mailto:forensic@example.com" }`,
+			record: "v=DMARC1; p=none; rua=mailto:a@example.com,mailto:b@example.net!10m; ruf=mailto:forensic@example.com",
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::dmarc_build(%s)
+							}
+						`, testCase.policy),
+						Check: resource.TestCheckOutput("result", testCase.record),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestDMARCBuildFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		policy string
+		error  string
+	}{
+		"empty-policy": {
+			policy: `{}`,
+			error:  `(?s)Call to function "provider::iactools::dmarc_build" failed.*The policy.*argument must be provided and valid`,
+		},
+		"missing-policy-tag": {
+			policy: `{ rua = "mailto:dmarc@example.com" }`,
+			error:  `(?s)Call to function "provider::iactools::dmarc_build" failed.*Error.*building.*the p tag is required`,
+		},
+		"unknown-tag": {
+			policy: `{ p = "reject", foo = "bar" }`,
+			error:  `(?s)Call to function "provider::iactools::dmarc_build" failed.*unknown DMARC tags:.*foo`,
+		},
+		"invalid-policy": {
+			policy: `{ p = "block" }`,
+			error:  `(?s)Call to function "provider::iactools::dmarc_build" failed.*invalid value "block" for DMARC tag.*p`,
+		},
+		"invalid-percentage": {
+			policy: `{ p = "reject", pct = 150 }`,
+			error:  `(?s)Call to function "provider::iactools::dmarc_build" failed.*invalid value "150" for DMARC tag.*pct`,
+		},
+		"invalid-alignment": {
+			policy: `{ p = "reject", adkim = "x" }`,
+			error:  `(?s)Call to function "provider::iactools::dmarc_build" failed.*invalid value "x" for DMARC tag.*adkim`,
+		},
+		"invalid-failure-options": {
+			policy: `{ p = "reject", fo = "1:x" }`,
+			error:  `(?s)Call to function "provider::iactools::dmarc_build" failed.*invalid value "1:x" for DMARC tag.*fo`,
+		},
+		"missing-mailto": {
+			policy: `{ p = "reject", rua = "dmarc@example.com" }`,
+			error:  `(?s)Call to function "provider::iactools::dmarc_build" failed.*invalid URI "dmarc@example.com".*must start.*with mailto:`,
+		},
+		"colliding-tags": {
+			policy: `{ P = "none", p = "reject" }`,
+			error:  `(?s)Call to function "provider::iactools::dmarc_build" failed.*DMARC tags "P" and "p" are the same.*tag p`,
+		},
+		"invalid-version": {
+			policy: `{ v = "DMARC2", p = "reject" }`,
+			error:  `(?s)Call to function "provider::iactools::dmarc_build" failed.*unsupported DMARC version.*"DMARC2"`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::dmarc_build(%s)
+							}
+						`, testCase.policy),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
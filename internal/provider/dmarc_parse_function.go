@@ -0,0 +1,75 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = DMARCParseFunction{}
+)
+
+// NewDMARCParseFunction is a helper function to create a new instance of DMARCParseFunction.
+func NewDMARCParseFunction() function.Function {
+	return DMARCParseFunction{}
+}
+
+// DMARCParseFunction is the struct for the DMARC parse function.
+type DMARCParseFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f DMARCParseFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "dmarc_parse"
+}
+
+// Definition sets the definition for the function.
+func (f DMARCParseFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parse a DMARC TXT record value into its tags",
+		MarkdownDescription: "Validates the tag names and values of a DMARC record and outputs them as a map with normalized values. Tag names are case-insensitive and output in lowercase.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "record",
+				MarkdownDescription: "The DMARC TXT record value, starting with `v=DMARC1`",
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+// Run executes the DMARC parse function.
+func (f DMARCParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var record string
+
+	// Parse the argument
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &record))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input argument
+	if record == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The record argument must be provided and valid"))
+		return
+	}
+
+	// Parse the DMARC record
+	tags, err := DMARCParse(record)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error parsing DMARC record: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, tags))
+}
@@ -0,0 +1,110 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestDMARCParseFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		record string
+		tags   string
+	}{
+		"minimal": {
+			record: "v=DMARC1; p=none",
+			tags:   `{"p":"none","v":"DMARC1"}`,
+		},
+		"uppercase-tags": {
+			record: "V=DMARC1; P=quarantine; PCT=50",
+			tags:   `{"p":"quarantine","pct":"50","v":"DMARC1"}`,
+		},
+		"full": {
+			record: "v=DMARC1;p=REJECT; sp=quarantine; pct=25; adkim=s; aspf=s; fo=0:1; rua=mailto:dmarc@example.com; ruf=mailto:forensic@example.com!1m;",
+			tags:   `{"adkim":"s","aspf":"s","fo":"0:1","p":"reject","pct":"25","rua":"mailto:dmarc@example.com","ruf":"mailto:forensic@example.com!1m","sp":"quarantine","v":"DMARC1"}`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::dmarc_parse("%s"))
+							}
+						`, testCase.record),
+						Check: resource.TestCheckOutput("result", testCase.tags),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestDMARCParseFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		record string
+		error  string
+	}{
+		"empty-record": {
+			record: "",
+			error:  `(?s)Call to function "provider::iactools::dmarc_parse" failed.*The record.*argument must be provided and valid`,
+		},
+		"missing-version": {
+			record: "p=reject; v=DMARC1",
+			error:  `(?s)Call to function "provider::iactools::dmarc_parse" failed.*Error.*parsing.*must start with v=DMARC1`,
+		},
+		"policy-not-second": {
+			record: "v=DMARC1; pct=100; p=reject",
+			error:  `(?s)Call to function "provider::iactools::dmarc_parse" failed.*the p tag must.*immediately follow the v tag`,
+		},
+		"malformed-tag": {
+			record: "v=DMARC1; p=reject; rua",
+			error:  `(?s)Call to function "provider::iactools::dmarc_parse" failed.*malformed DMARC tag.*"rua"`,
+		},
+		"duplicate-tag": {
+			record: "v=DMARC1; p=reject; p=none",
+			error:  `(?s)Call to function "provider::iactools::dmarc_parse" failed.*duplicate DMARC tag.*"p"`,
+		},
+		"duplicate-tag-different-case": {
+			record: "v=DMARC1; p=reject; P=none",
+			error:  `(?s)Call to function "provider::iactools::dmarc_parse" failed.*duplicate DMARC tag.*"p"`,
+		},
+		"invalid-email": {
+			record: "v=DMARC1; p=reject; rua=mailto:not-an-address",
+			error:  `(?s)Call to function "provider::iactools::dmarc_parse" failed.*invalid email address.*"not-an-address"`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::dmarc_parse("%s")
+							}
+						`, testCase.record),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = DNS64SynthesizeFunction{}
+)
+
+// NewDNS64SynthesizeFunction is a helper function to create a new instance of DNS64SynthesizeFunction.
+func NewDNS64SynthesizeFunction() function.Function {
+	return DNS64SynthesizeFunction{}
+}
+
+// DNS64SynthesizeFunction is the struct for the DNS64 synthesize function.
+type DNS64SynthesizeFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f DNS64SynthesizeFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "dns64_synthesize"
+}
+
+// Definition sets the definition for the function.
+func (f DNS64SynthesizeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Synthesize the DNS64 AAAA record of an IPv4 address",
+		MarkdownDescription: "Outputs the `aaaa` value a DNS64 resolver synthesizes for an IPv4 address with the NAT64 prefix (see `nat64_embed`), " +
+			"and the `ptr_name` of the address in the `ip6.arpa` zone, e.g. for static AAAA and PTR records of IPv4-only services.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "ipv4_address",
+				MarkdownDescription: "The IPv4 address of the A record",
+			},
+			function.StringParameter{
+				Name:                "prefix",
+				MarkdownDescription: "The NAT64 prefix, defaults to the well-known prefix `64:ff9b::/96` when null",
+				AllowNullValue:      true,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"aaaa":     types.StringType,
+				"ptr_name": types.StringType,
+			},
+		},
+	}
+}
+
+// Run executes the DNS64 synthesize function.
+func (f DNS64SynthesizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ipv4Address string
+	var prefix *string
+
+	// Parse the arguments
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &ipv4Address, &prefix))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input arguments
+	if ipv4Address == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The ipv4_address argument must be provided and valid"))
+		return
+	}
+
+	// Synthesize the AAAA record
+	record, err := DNS64Synthesize(ipv4Address, stringValueOrEmpty(prefix))
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error synthesizing AAAA record: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, record))
+}
@@ -0,0 +1,86 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestDNS64SynthesizeFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		result    string
+	}{
+		"well-known-prefix": {
+			arguments: `"8.8.8.8", null`,
+			result:    `{"aaaa":"64:ff9b::808:808","ptr_name":"8.0.8.0.8.0.8.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.b.9.f.f.4.6.0.0.ip6.arpa."}`,
+		},
+		"network-specific-prefix": {
+			arguments: `"10.1.2.3", "2001:db8:64::/96"`,
+			result:    `{"aaaa":"2001:db8:64::a01:203","ptr_name":"3.0.2.0.1.0.a.0.0.0.0.0.0.0.0.0.0.0.0.0.4.6.0.0.8.b.d.0.1.0.0.2.ip6.arpa."}`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::dns64_synthesize(%s))
+							}
+						`, testCase.arguments),
+						Check: resource.TestCheckOutput("result", testCase.result),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestDNS64SynthesizeFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		error     string
+	}{
+		"empty": {
+			arguments: `"", null`,
+			error:     `(?s)Call to function "provider::iactools::dns64_synthesize" failed.*The\s+ipv4_address\s+argument\s+must\s+be\s+provided\s+and\s+valid`,
+		},
+		"well-known-private": {
+			arguments: `"192.168.1.1", null`,
+			error:     `(?s)Call to function "provider::iactools::dns64_synthesize" failed.*Error\s+synthesizing\s+AAAA\s+record:\s+the\s+well-known\s+prefix\s+64:ff9b::/96\s+must\s+not\s+be\s+used\s+with\s+the\s+non-global\s+IPv4\s+address\s+192.168.1.1`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::dns64_synthesize(%s)
+							}
+						`, testCase.arguments),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
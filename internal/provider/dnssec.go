@@ -0,0 +1,225 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"strconv"
+	"strings"
+)
+
+// dnssecAlgorithms maps the DNSSEC algorithm numbers to their mnemonics, as registered by IANA.
+var dnssecAlgorithms = map[int64]string{
+	1:  "RSAMD5",
+	3:  "DSA",
+	5:  "RSASHA1",
+	6:  "DSA-NSEC3-SHA1",
+	7:  "RSASHA1-NSEC3-SHA1",
+	8:  "RSASHA256",
+	10: "RSASHA512",
+	12: "ECC-GOST",
+	13: "ECDSAP256SHA256",
+	14: "ECDSAP384SHA384",
+	15: "ED25519",
+	16: "ED448",
+}
+
+// DNSKEY flag bits, as defined in RFC 4034 section 2.1.1 and RFC 5011 section 3.
+const (
+	dnskeyFlagZoneKey          = 0x0100
+	dnskeyFlagRevoke           = 0x0080
+	dnskeyFlagSecureEntryPoint = 0x0001
+)
+
+// DNSKEY protocol and algorithm numbers with special handling.
+const (
+	dnskeyProtocol        = 3
+	dnskeyAlgorithmRSAMD5 = 1
+)
+
+// DS digest type numbers, as registered by IANA.
+const (
+	dnssecDigestTypeSHA1   = 1
+	dnssecDigestTypeSHA256 = 2
+	dnssecDigestTypeGOST   = 3
+	dnssecDigestTypeSHA384 = 4
+)
+
+// DNSKEY holds the fields of a DNSKEY record.
+type DNSKEY struct {
+	Flags            int64  `tfsdk:"flags"`
+	Protocol         int64  `tfsdk:"protocol"`
+	Algorithm        int64  `tfsdk:"algorithm"`
+	AlgorithmName    string `tfsdk:"algorithm_name"`
+	KeyTag           int64  `tfsdk:"key_tag"`
+	PublicKey        string `tfsdk:"public_key"`
+	ZoneKey          bool   `tfsdk:"zone_key"`
+	SecureEntryPoint bool   `tfsdk:"secure_entry_point"`
+	Revoked          bool   `tfsdk:"revoked"`
+}
+
+// DS holds the fields of a DS record.
+type DS struct {
+	KeyTag     int64  `tfsdk:"key_tag"`
+	Algorithm  int64  `tfsdk:"algorithm"`
+	DigestType int64  `tfsdk:"digest_type"`
+	Digest     string `tfsdk:"digest"`
+	Record     string `tfsdk:"record"`
+}
+
+// DNSKEYParse parses the presentation format of a DNSKEY record data and calculates its key tag.
+func DNSKEYParse(rdata string) (*DNSKEY, error) {
+	dnskey, _, err := parseDNSKEY(rdata)
+	return dnskey, err
+}
+
+// DNSSECDS computes the DS record of a DNSKEY record for its owner name.
+func DNSSECDS(owner, rdata string, digestType int64) (*DS, error) {
+	ownerWire, err := canonicalNameWire(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	dnskey, dnskeyWire, err := parseDNSKEY(rdata)
+	if err != nil {
+		return nil, err
+	}
+	if !dnskey.ZoneKey {
+		return nil, fmt.Errorf("DNSKEY with flags %d is not a zone key, DS records can only reference zone keys", dnskey.Flags)
+	}
+
+	var digester hash.Hash
+	switch digestType {
+	case dnssecDigestTypeSHA256:
+		digester = sha256.New()
+	case dnssecDigestTypeSHA384:
+		digester = sha512.New384()
+	case dnssecDigestTypeSHA1, dnssecDigestTypeGOST:
+		return nil, fmt.Errorf("digest type %d must not be used for new DS records, use 2 (SHA-256) or 4 (SHA-384)", digestType)
+	default:
+		return nil, fmt.Errorf("unsupported digest type %d, use 2 (SHA-256) or 4 (SHA-384)", digestType)
+	}
+
+	// The digest is calculated over the owner name and the DNSKEY RDATA, as defined in RFC 4034 section 5.1.4
+	digester.Write(ownerWire)
+	digester.Write(dnskeyWire)
+	digest := strings.ToUpper(hex.EncodeToString(digester.Sum(nil)))
+
+	return &DS{
+		KeyTag:     dnskey.KeyTag,
+		Algorithm:  dnskey.Algorithm,
+		DigestType: digestType,
+		Digest:     digest,
+		Record:     fmt.Sprintf("%d %d %d %s", dnskey.KeyTag, dnskey.Algorithm, digestType, digest),
+	}, nil
+}
+
+// Helper functions
+
+// parseDNSKEY parses a DNSKEY record data and returns it together with its wire format.
+func parseDNSKEY(rdata string) (*DNSKEY, []byte, error) {
+	fields := strings.Fields(strings.NewReplacer("(", " ", ")", " ").Replace(rdata))
+	if len(fields) < 4 {
+		return nil, nil, fmt.Errorf("DNSKEY record data must contain flags, protocol, algorithm and public key")
+	}
+
+	flags, err := strconv.ParseUint(fields[0], 10, 16)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid DNSKEY flags %q: must be an integer between 0 and 65535", fields[0])
+	}
+
+	protocol, err := strconv.ParseUint(fields[1], 10, 8)
+	if err != nil || protocol != dnskeyProtocol {
+		return nil, nil, fmt.Errorf("invalid DNSKEY protocol %q: must be 3", fields[1])
+	}
+
+	algorithm, err := strconv.ParseUint(fields[2], 10, 8)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid DNSKEY algorithm %q: must be an integer between 0 and 255", fields[2])
+	}
+	algorithmName, ok := dnssecAlgorithms[int64(algorithm)]
+	if !ok {
+		return nil, nil, fmt.Errorf("unsupported DNSKEY algorithm %d", algorithm)
+	}
+
+	publicKey := strings.Join(fields[3:], "")
+	key, err := base64.StdEncoding.DecodeString(publicKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid DNSKEY public key: %v", err)
+	}
+	if len(key) == 0 {
+		return nil, nil, fmt.Errorf("invalid DNSKEY public key: key is empty")
+	}
+
+	// Assemble the DNSKEY RDATA in wire format, as defined in RFC 4034 section 2.1
+	wire := make([]byte, 4, 4+len(key))
+	binary.BigEndian.PutUint16(wire[0:2], uint16(flags))
+	wire[2] = byte(protocol)
+	wire[3] = byte(algorithm)
+	wire = append(wire, key...)
+
+	return &DNSKEY{
+		Flags:            int64(flags),
+		Protocol:         int64(protocol),
+		Algorithm:        int64(algorithm),
+		AlgorithmName:    algorithmName,
+		KeyTag:           int64(dnskeyKeyTag(wire, key, int64(algorithm))),
+		PublicKey:        base64.StdEncoding.EncodeToString(key),
+		ZoneKey:          flags&dnskeyFlagZoneKey != 0,
+		SecureEntryPoint: flags&dnskeyFlagSecureEntryPoint != 0,
+		Revoked:          flags&dnskeyFlagRevoke != 0,
+	}, wire, nil
+}
+
+// dnskeyKeyTag calculates the key tag of a DNSKEY RDATA, as defined in RFC 4034 appendix B.
+func dnskeyKeyTag(wire, key []byte, algorithm int64) uint16 {
+	if algorithm == dnskeyAlgorithmRSAMD5 {
+		// The key tag of RSA/MD5 keys is the most significant 16 bits of the least significant 24 bits of the modulus
+		if len(key) < 3 {
+			return 0
+		}
+		return binary.BigEndian.Uint16(key[len(key)-3 : len(key)-1])
+	}
+
+	var accumulator uint32
+	for i, b := range wire {
+		if i&1 == 1 {
+			accumulator += uint32(b)
+		} else {
+			accumulator += uint32(b) << 8
+		}
+	}
+	accumulator += (accumulator >> 16) & 0xFFFF
+	return uint16(accumulator & 0xFFFF)
+}
+
+// canonicalNameWire converts a domain name to its canonical wire format, as defined in RFC 4034 section 6.2.
+func canonicalNameWire(name string) ([]byte, error) {
+	name = strings.ToLower(strings.TrimSuffix(strings.TrimSpace(name), "."))
+	if name == "" {
+		return []byte{0}, nil
+	}
+
+	wire := make([]byte, 0, len(name)+2)
+	for _, label := range strings.Split(name, ".") {
+		if label == "" || len(label) > 63 {
+			return nil, fmt.Errorf("invalid owner name %q: labels must be between 1 and 63 characters", name)
+		}
+		wire = append(wire, byte(len(label)))
+		wire = append(wire, label...)
+	}
+	wire = append(wire, 0)
+
+	if len(wire) > 255 {
+		return nil, fmt.Errorf("invalid owner name %q: name must not exceed 255 octets", name)
+	}
+	return wire, nil
+}
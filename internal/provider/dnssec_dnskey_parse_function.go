@@ -0,0 +1,87 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = DNSKEYParseFunction{}
+)
+
+// NewDNSKEYParseFunction is a helper function to create a new instance of DNSKEYParseFunction.
+func NewDNSKEYParseFunction() function.Function {
+	return DNSKEYParseFunction{}
+}
+
+// DNSKEYParseFunction is the struct for the DNSKEY parse function.
+type DNSKEYParseFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f DNSKEYParseFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "dnssec_dnskey_parse"
+}
+
+// Definition sets the definition for the function.
+func (f DNSKEYParseFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse a DNSKEY record and calculate its key tag",
+		MarkdownDescription: "Parses the presentation format of a DNSKEY record data and outputs an object with its flags, protocol, " +
+			"algorithm, key tag as defined in RFC 4034 appendix B, and the decoded flag bits.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "dnskey_rdata",
+				MarkdownDescription: "The DNSKEY record data in presentation format, e.g. `257 3 13 <public key>`",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"flags":              types.Int64Type,
+				"protocol":           types.Int64Type,
+				"algorithm":          types.Int64Type,
+				"algorithm_name":     types.StringType,
+				"key_tag":            types.Int64Type,
+				"public_key":         types.StringType,
+				"zone_key":           types.BoolType,
+				"secure_entry_point": types.BoolType,
+				"revoked":            types.BoolType,
+			},
+		},
+	}
+}
+
+// Run executes the DNSKEY parse function.
+func (f DNSKEYParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var dnskeyRdata string
+
+	// Parse the argument
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &dnskeyRdata))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input argument
+	if dnskeyRdata == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The dnskey_rdata argument must be provided and valid"))
+		return
+	}
+
+	// Parse the DNSKEY record
+	dnskey, err := DNSKEYParse(dnskeyRdata)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error parsing DNSKEY record: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, dnskey))
+}
@@ -0,0 +1,98 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestDNSKEYParseFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		dnskeyRdata string
+		dnskey      string
+	}{
+		"rfc4034-zone-signing-key": {
+			dnskeyRdata: "256 3 5 AQOeiiR0GOMYkDshWoSKz9XzfwJr1AYtsmx3TGkJaNXVbfi/2pHm822aJ5iI9BMzNXxeYCmZDRD99WYwYqUSdjMmmAphXdvxegXd/M5+X7OrzKBaMbCVdFLUUh6DhweJBjEVv5f2wwjM9XzcnOf+EPbtG9DMBmADjFDc2w/rljwvFw==",
+			dnskey:      `{"algorithm":5,"algorithm_name":"RSASHA1","flags":256,"key_tag":60485,"protocol":3,"revoked":false,"secure_entry_point":false,"zone_key":true}`,
+		},
+		"rfc6605-key-signing-key": {
+			dnskeyRdata: "257 3 14 xKYaNhWdGOfJ+nPrL8/arkwf2EY3MDJ+SErKivBVSum1w/egsXvSADtNJhyem5RCOpgQ6K8X1DRSEkrbYQ+OB+v8/uX45NBwY8rp65F6Glur8I/mlVNgF6W/qTI37m40",
+			dnskey:      `{"algorithm":14,"algorithm_name":"ECDSAP384SHA384","flags":257,"key_tag":10771,"protocol":3,"revoked":false,"secure_entry_point":true,"zone_key":true}`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode({ for k, v in provider::iactools::dnssec_dnskey_parse("%s") : k => v if k != "public_key" })
+							}
+						`, testCase.dnskeyRdata),
+						Check: resource.TestCheckOutput("result", testCase.dnskey),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestDNSKEYParseFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		dnskeyRdata string
+		error       string
+	}{
+		"empty-dnskey": {
+			dnskeyRdata: "",
+			error:       `(?s)Call to function "provider::iactools::dnssec_dnskey_parse" failed.*The.*dnskey_rdata argument must be provided and valid`,
+		},
+		"missing-fields": {
+			dnskeyRdata: "257 3 13",
+			error:       `(?s)Call to function "provider::iactools::dnssec_dnskey_parse" failed.*must contain flags,.*protocol,.*algorithm and public key`,
+		},
+		"invalid-protocol": {
+			dnskeyRdata: "257 2 13 AQID",
+			error:       `(?s)Call to function "provider::iactools::dnssec_dnskey_parse" failed.*invalid DNSKEY.*protocol "2"`,
+		},
+		"unknown-algorithm": {
+			dnskeyRdata: "257 3 99 AQID",
+			error:       `(?s)Call to function "provider::iactools::dnssec_dnskey_parse" failed.*unsupported.*DNSKEY algorithm 99`,
+		},
+		"invalid-public-key": {
+			dnskeyRdata: "257 3 13 not-base64!",
+			error:       `(?s)Call to function "provider::iactools::dnssec_dnskey_parse" failed.*invalid DNSKEY.*public key`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::dnssec_dnskey_parse("%s")
+							}
+						`, testCase.dnskeyRdata),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
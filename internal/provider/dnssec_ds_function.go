@@ -0,0 +1,96 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = DNSSECDSFunction{}
+)
+
+// NewDNSSECDSFunction is a helper function to create a new instance of DNSSECDSFunction.
+func NewDNSSECDSFunction() function.Function {
+	return DNSSECDSFunction{}
+}
+
+// DNSSECDSFunction is the struct for the DNSSEC DS function.
+type DNSSECDSFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f DNSSECDSFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "dnssec_ds"
+}
+
+// Definition sets the definition for the function.
+func (f DNSSECDSFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Compute the DS record of a DNSKEY record",
+		MarkdownDescription: "Computes the key tag and the SHA-256 or SHA-384 digest of a DNSKEY record as defined in RFC 4034, " +
+			"and outputs an object with the DS record fields and the complete `record` value for the parent zone.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "owner",
+				MarkdownDescription: "The owner name of the DNSKEY record, which is the name of the delegated zone",
+			},
+			function.StringParameter{
+				Name:                "dnskey_rdata",
+				MarkdownDescription: "The DNSKEY record data in presentation format, e.g. `257 3 13 <public key>`",
+			},
+			function.Int64Parameter{
+				Name:                "digest_type",
+				MarkdownDescription: "The digest type of the DS record, `2` for SHA-256 or `4` for SHA-384",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"key_tag":     types.Int64Type,
+				"algorithm":   types.Int64Type,
+				"digest_type": types.Int64Type,
+				"digest":      types.StringType,
+				"record":      types.StringType,
+			},
+		},
+	}
+}
+
+// Run executes the DNSSEC DS function.
+func (f DNSSECDSFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var owner, dnskeyRdata string
+	var digestType int64
+
+	// Parse the arguments
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &owner, &dnskeyRdata, &digestType))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input arguments
+	if owner == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The owner argument must be provided and valid"))
+		return
+	}
+	if dnskeyRdata == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The dnskey_rdata argument must be provided and valid"))
+		return
+	}
+
+	// Compute the DS record
+	ds, err := DNSSECDS(owner, dnskeyRdata, digestType)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error computing DS record: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, ds))
+}
@@ -0,0 +1,128 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestDNSSECDSFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		owner       string
+		dnskeyRdata string
+		digestType  int
+		record      string
+	}{
+		"rfc4509-sha256": {
+			owner:       "dskey.example.com.",
+			dnskeyRdata: "256 3 5 AQOeiiR0GOMYkDshWoSKz9XzfwJr1AYtsmx3TGkJaNXVbfi/2pHm822aJ5iI9BMzNXxeYCmZDRD99WYwYqUSdjMmmAphXdvxegXd/M5+X7OrzKBaMbCVdFLUUh6DhweJBjEVv5f2wwjM9XzcnOf+EPbtG9DMBmADjFDc2w/rljwvFw==",
+			digestType:  2,
+			record:      "60485 5 2 D4B7D520E7BB5F0F67674A0CCEB1E3E0614B93C4F9E99B8383F6A1E4469DA50A",
+		},
+		"rfc6605-sha384": {
+			owner:       "example.net",
+			dnskeyRdata: "257 3 14 ( xKYaNhWdGOfJ+nPrL8/arkwf2EY3MDJ+SErKivBVSum1 w/egsXvSADtNJhyem5RCOpgQ6K8X1DRSEkrbYQ+OB+v8 /uX45NBwY8rp65F6Glur8I/mlVNgF6W/qTI37m40 )",
+			digestType:  4,
+			record:      "10771 14 4 72D7B62976CE06438E9C0BF319013CF801F09ECC84B8D7E9495F27E305C6A9B0563A9B5F4D288405C3008A946DF983D6",
+		},
+		"case-insensitive-owner": {
+			owner:       "DSKEY.Example.COM",
+			dnskeyRdata: "256 3 5 AQOeiiR0GOMYkDshWoSKz9XzfwJr1AYtsmx3TGkJaNXVbfi/2pHm822aJ5iI9BMzNXxeYCmZDRD99WYwYqUSdjMmmAphXdvxegXd/M5+X7OrzKBaMbCVdFLUUh6DhweJBjEVv5f2wwjM9XzcnOf+EPbtG9DMBmADjFDc2w/rljwvFw==",
+			digestType:  2,
+			record:      "60485 5 2 D4B7D520E7BB5F0F67674A0CCEB1E3E0614B93C4F9E99B8383F6A1E4469DA50A",
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::dnssec_ds("%s", "%s", %d).record
+							}
+						`, testCase.owner, testCase.dnskeyRdata, testCase.digestType),
+						Check: resource.TestCheckOutput("result", testCase.record),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestDNSSECDSFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		owner       string
+		dnskeyRdata string
+		digestType  int
+		error       string
+	}{
+		"empty-owner": {
+			owner:       "",
+			dnskeyRdata: "257 3 13 AQID",
+			digestType:  2,
+			error:       `(?s)Call to function "provider::iactools::dnssec_ds" failed.*The owner argument.*must be provided and valid`,
+		},
+		"empty-dnskey": {
+			owner:       "example.com",
+			dnskeyRdata: "",
+			digestType:  2,
+			error:       `(?s)Call to function "provider::iactools::dnssec_ds" failed.*The dnskey_rdata.*argument must be provided and valid`,
+		},
+		"sha1-digest": {
+			owner:       "example.com",
+			dnskeyRdata: "257 3 13 AQID",
+			digestType:  1,
+			error:       `(?s)Call to function "provider::iactools::dnssec_ds" failed.*digest type 1 must.*not be used`,
+		},
+		"unknown-digest": {
+			owner:       "example.com",
+			dnskeyRdata: "257 3 13 AQID",
+			digestType:  9,
+			error:       `(?s)Call to function "provider::iactools::dnssec_ds" failed.*unsupported digest.*type 9`,
+		},
+		"not-a-zone-key": {
+			owner:       "example.com",
+			dnskeyRdata: "1 3 13 AQID",
+			digestType:  2,
+			error:       `(?s)Call to function "provider::iactools::dnssec_ds" failed.*is not a zone.*key`,
+		},
+		"invalid-owner": {
+			owner:       "example..com",
+			dnskeyRdata: "257 3 13 AQID",
+			digestType:  2,
+			error:       `(?s)Call to function "provider::iactools::dnssec_ds" failed.*invalid owner name.*"example..com"`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::dnssec_ds("%s", "%s", %d)
+							}
+						`, testCase.owner, testCase.dnskeyRdata, testCase.digestType),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
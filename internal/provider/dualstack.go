@@ -0,0 +1,97 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// Strategies of mapping IPv4 subnets to IPv6 subnets.
+const (
+	dualStackStrategyIndex = "index"
+	dualStackStrategyEmbed = "embed"
+	dualStackStrategyHash  = "hash"
+)
+
+// dualStackSubnetOnes is the prefix length of the IPv6 subnets mapped to the IPv4 subnets.
+const dualStackSubnetOnes = 64
+
+// DualStackMap maps every IPv4 subnet to an IPv6 /64 subnet of the parent IPv6 network.
+// The index strategy uses the position of the IPv4 subnet in the list, the embed strategy uses the third octet
+// of the IPv4 subnet as the IPv6 subnet ID, and the hash strategy uses the SHA-256 hash of the IPv4 subnet.
+// Two IPv4 subnets mapped to the same IPv6 subnet are reported as an error.
+func DualStackMap(ipv4Subnets []string, ipv6Parent, strategy string) (map[string]string, error) {
+	_, parent, err := net.ParseCIDR(strings.TrimSpace(ipv6Parent))
+	if err != nil {
+		return nil, fmt.Errorf("invalid IPv6 parent: %v", err)
+	}
+	ones, bits := parent.Mask.Size()
+	if bits != 8*net.IPv6len {
+		return nil, fmt.Errorf("parent %s is not an IPv6 network", ipv6Parent)
+	}
+	if ones > dualStackSubnetOnes {
+		return nil, fmt.Errorf("parent %s is longer than /%d", ipv6Parent, dualStackSubnetOnes)
+	}
+	// The subnet IDs are limited to 63 bits to fit the subnet index
+	subnetIDBits := min(dualStackSubnetOnes-ones, 63)
+	capacity := uint64(1) << subnetIDBits
+
+	strategy = strings.ToLower(strategy)
+	switch strategy {
+	case "":
+		strategy = dualStackStrategyIndex
+	case dualStackStrategyIndex, dualStackStrategyHash:
+	case dualStackStrategyEmbed:
+		if subnetIDBits < 8 {
+			return nil, fmt.Errorf("parent %s has room for %d /%d subnets, the embed strategy needs a /%d or larger parent for the 256 values of the third octet",
+				ipv6Parent, capacity, dualStackSubnetOnes, dualStackSubnetOnes-8)
+		}
+	default:
+		return nil, fmt.Errorf("unknown strategy %q, must be one of %s, %s or %s", strategy, dualStackStrategyIndex, dualStackStrategyEmbed, dualStackStrategyHash)
+	}
+	if strategy == dualStackStrategyIndex && uint64(len(ipv4Subnets)) > capacity {
+		return nil, fmt.Errorf("parent %s has room for %d /%d subnets, %d are needed", ipv6Parent, capacity, dualStackSubnetOnes, len(ipv4Subnets))
+	}
+
+	result := make(map[string]string, len(ipv4Subnets))
+	networks := make(map[string]string, len(ipv4Subnets))
+	owners := make(map[uint64]string, len(ipv4Subnets))
+	for i, subnet := range ipv4Subnets {
+		_, ipnet, err := net.ParseCIDR(strings.TrimSpace(subnet))
+		if err != nil {
+			return nil, fmt.Errorf("invalid IPv4 subnet: %v", err)
+		}
+		if len(ipnet.IP) != net.IPv4len {
+			return nil, fmt.Errorf("subnet %s is not an IPv4 network", subnet)
+		}
+		if other, ok := networks[ipnet.String()]; ok {
+			return nil, fmt.Errorf("duplicate IPv4 subnets %s and %s", other, subnet)
+		}
+		networks[ipnet.String()] = subnet
+
+		var index uint64
+		switch strategy {
+		case dualStackStrategyIndex:
+			index = uint64(i)
+		case dualStackStrategyEmbed:
+			index = uint64(ipnet.IP[2])
+		case dualStackStrategyHash:
+			sum := sha256.Sum256([]byte(ipnet.String()))
+			index = binary.BigEndian.Uint64(sum[:8]) % capacity
+		}
+
+		ipv6Subnet := subnetAt(parent, dualStackSubnetOnes, int64(index)).String()
+		if other, ok := owners[index]; ok {
+			return nil, fmt.Errorf("IPv4 subnets %s and %s both map to IPv6 subnet %s with the %s strategy", other, subnet, ipv6Subnet, strategy)
+		}
+		owners[index] = subnet
+		result[subnet] = ipv6Subnet
+	}
+	return result, nil
+}
@@ -0,0 +1,91 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = DualStackMapFunction{}
+)
+
+// NewDualStackMapFunction is a helper function to create a new instance of DualStackMapFunction.
+func NewDualStackMapFunction() function.Function {
+	return DualStackMapFunction{}
+}
+
+// DualStackMapFunction is the struct for the dual-stack map function.
+type DualStackMapFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f DualStackMapFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "dualstack_map"
+}
+
+// Definition sets the definition for the function.
+func (f DualStackMapFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Map IPv4 subnets to IPv6 /64 subnets for dual-stack networks",
+		MarkdownDescription: "Assigns an IPv6 /64 subnet of the IPv6 parent network to every IPv4 subnet, and outputs a map of the IPv6 subnets by IPv4 subnet. " +
+			"The `index` strategy assigns the /64 subnets in the order of the IPv4 subnets, so new IPv4 subnets must be appended to the list to keep the existing assignments. " +
+			"The `embed` strategy uses the third octet of the IPv4 subnet as the IPv6 subnet ID, e.g. `10.1.5.0/24` maps to `2001:db8:0:5::/64` in `2001:db8::/48`, and needs a /56 or larger parent. " +
+			"The `hash` strategy derives the IPv6 subnet ID from the SHA-256 hash of the IPv4 subnet, so every assignment only depends on its own IPv4 subnet. " +
+			"The function fails when two IPv4 subnets map to the same IPv6 subnet, e.g. two subnets with the same third octet with the `embed` strategy.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "ipv4_subnets",
+				ElementType:         types.StringType,
+				MarkdownDescription: "The list of IPv4 subnets",
+			},
+			function.StringParameter{
+				Name:                "ipv6_parent",
+				MarkdownDescription: "The IPv6 network to assign the /64 subnets from",
+			},
+			function.StringParameter{
+				Name:                "strategy",
+				MarkdownDescription: "The mapping strategy: `index`, `embed` or `hash`. Defaults to `index` when null.",
+				AllowNullValue:      true,
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+// Run executes the dual-stack map function.
+func (f DualStackMapFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ipv4Subnets []string
+	var ipv6Parent string
+	var strategy *string
+
+	// Parse the arguments
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &ipv4Subnets, &ipv6Parent, &strategy))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input arguments
+	if ipv6Parent == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The ipv6_parent argument must be provided and valid"))
+		return
+	}
+
+	// Map the IPv4 subnets
+	mapping, err := DualStackMap(ipv4Subnets, ipv6Parent, stringValueOrEmpty(strategy))
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error mapping IPv4 subnets: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, mapping))
+}
@@ -0,0 +1,142 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestDualStackMapFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		result    string
+	}{
+		"index": {
+			arguments: `["10.1.0.0/24", "10.1.1.0/24", "10.2.0.0/16"], "2001:db8::/48", null`,
+			result:    `{"10.1.0.0/24":"2001:db8::/64","10.1.1.0/24":"2001:db8:0:1::/64","10.2.0.0/16":"2001:db8:0:2::/64"}`,
+		},
+		"index-parent-host-bits": {
+			arguments: `["10.1.0.0/24", "10.1.1.0/24"], "2001:db8:0:1234::1/56", "INDEX"`,
+			result:    `{"10.1.0.0/24":"2001:db8:0:1200::/64","10.1.1.0/24":"2001:db8:0:1201::/64"}`,
+		},
+		"embed": {
+			arguments: `["10.1.5.0/24", "10.1.200.0/24", "10.1.16.0/20"], "2001:db8::/48", "embed"`,
+			result:    `{"10.1.16.0/20":"2001:db8:0:10::/64","10.1.200.0/24":"2001:db8:0:c8::/64","10.1.5.0/24":"2001:db8:0:5::/64"}`,
+		},
+		"embed-56": {
+			arguments: `["10.1.255.0/24"], "2001:db8:0:ff00::/56", "embed"`,
+			result:    `{"10.1.255.0/24":"2001:db8:0:ffff::/64"}`,
+		},
+		"hash": {
+			arguments: `["10.1.0.0/24", "10.1.1.0/24", "10.2.0.0/16"], "2001:db8::/48", "hash"`,
+			result:    `{"10.1.0.0/24":"2001:db8:0:449b::/64","10.1.1.0/24":"2001:db8:0:f3ed::/64","10.2.0.0/16":"2001:db8:0:a2e8::/64"}`,
+		},
+		"hash-56": {
+			arguments: `["10.1.0.0/24"], "2001:db8:0:ff00::/56", "hash"`,
+			result:    `{"10.1.0.0/24":"2001:db8:0:ff9b::/64"}`,
+		},
+		"empty": {
+			arguments: `[], "2001:db8::/48", "hash"`,
+			result:    `{}`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::dualstack_map(%s))
+							}
+						`, testCase.arguments),
+						Check: resource.TestCheckOutput("result", testCase.result),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestDualStackMapFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		error     string
+	}{
+		"empty-parent": {
+			arguments: `["10.1.0.0/24"], "", null`,
+			error:     `(?s)Call to function "provider::iactools::dualstack_map" failed.*The\s+ipv6_parent\s+argument\s+must\s+be\s+provided\s+and\s+valid`,
+		},
+		"ipv4-parent": {
+			arguments: `["10.1.0.0/24"], "10.0.0.0/8", null`,
+			error:     `(?s)Call to function "provider::iactools::dualstack_map" failed.*Error\s+mapping\s+IPv4\s+subnets:\s+parent\s+10.0.0.0/8\s+is\s+not\s+an\s+IPv6\s+network`,
+		},
+		"parent-too-long": {
+			arguments: `["10.1.0.0/24"], "2001:db8::/96", null`,
+			error:     `(?s)Call to function "provider::iactools::dualstack_map" failed.*parent\s+2001:db8::/96\s+is\s+longer\s+than\s+/64`,
+		},
+		"unknown-strategy": {
+			arguments: `["10.1.0.0/24"], "2001:db8::/48", "random"`,
+			error:     `(?s)Call to function "provider::iactools::dualstack_map" failed.*unknown\s+strategy\s+"random",\s+must\s+be\s+one\s+of\s+index,\s+embed\s+or\s+hash`,
+		},
+		"ipv6-subnet": {
+			arguments: `["2001:db8::/64"], "2001:db8::/48", null`,
+			error:     `(?s)Call to function "provider::iactools::dualstack_map" failed.*subnet\s+2001:db8::/64\s+is\s+not\s+an\s+IPv4\s+network`,
+		},
+		"invalid-subnet": {
+			arguments: `["10.1.0.0/33"], "2001:db8::/48", null`,
+			error:     `(?s)Call to function "provider::iactools::dualstack_map" failed.*Error\s+mapping\s+IPv4\s+subnets:\s+invalid\s+IPv4\s+subnet`,
+		},
+		"duplicate-subnet": {
+			arguments: `["10.1.0.0/24", "10.1.0.1/24"], "2001:db8::/48", null`,
+			error:     `(?s)Call to function "provider::iactools::dualstack_map" failed.*duplicate\s+IPv4\s+subnets\s+10.1.0.0/24\s+and\s+10.1.0.1/24`,
+		},
+		"index-exhausted": {
+			arguments: `["10.1.0.0/24", "10.1.1.0/24", "10.1.2.0/24"], "2001:db8::/63", null`,
+			error:     `(?s)Call to function "provider::iactools::dualstack_map" failed.*parent\s+2001:db8::/63\s+has\s+room\s+for\s+2\s+/64\s+subnets,\s+3\s+are\s+needed`,
+		},
+		"embed-parent-too-small": {
+			arguments: `["10.1.0.0/24"], "2001:db8::/60", "embed"`,
+			error:     `(?s)Call to function "provider::iactools::dualstack_map" failed.*parent\s+2001:db8::/60\s+has\s+room\s+for\s+16\s+/64\s+subnets,\s+the\s+embed\s+strategy\s+needs\s+a\s+/56\s+or\s+larger\s+parent`,
+		},
+		"embed-collision": {
+			arguments: `["10.1.5.0/24", "10.2.5.0/24"], "2001:db8::/48", "embed"`,
+			error:     `(?s)Call to function "provider::iactools::dualstack_map" failed.*IPv4\s+subnets\s+10.1.5.0/24\s+and\s+10.2.5.0/24\s+both\s+map\s+to\s+IPv6\s+subnet\s+2001:db8:0:5::/64\s+with\s+the\s+embed\s+strategy`,
+		},
+		"hash-collision": {
+			arguments: `["10.1.0.0/24", "10.1.1.0/24", "10.2.0.0/16"], "2001:db8::/63", "hash"`,
+			error:     `(?s)Call to function "provider::iactools::dualstack_map" failed.*IPv4\s+subnets\s+10.1.0.0/24\s+and\s+10.1.1.0/24\s+both\s+map\s+to\s+IPv6\s+subnet\s+2001:db8:0:1::/64\s+with\s+the\s+hash\s+strategy`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::dualstack_map(%s)
+							}
+						`, testCase.arguments),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// dynamicToGo converts a Terraform value of any type into plain Go values.
+// Objects and maps become map[string]any, lists, sets and tuples become []any,
+// numbers become *big.Float and null values become nil.
+func dynamicToGo(value attr.Value) (any, error) {
+	if value == nil || value.IsNull() {
+		return nil, nil
+	}
+	if value.IsUnknown() {
+		return nil, fmt.Errorf("value is not yet known")
+	}
+
+	switch v := value.(type) {
+	case types.Dynamic:
+		return dynamicToGo(v.UnderlyingValue())
+	case types.String:
+		return v.ValueString(), nil
+	case types.Bool:
+		return v.ValueBool(), nil
+	case types.Number:
+		return v.ValueBigFloat(), nil
+	case types.Int64:
+		return new(big.Float).SetInt64(v.ValueInt64()), nil
+	case types.Int32:
+		return new(big.Float).SetInt64(int64(v.ValueInt32())), nil
+	case types.Float64:
+		return new(big.Float).SetFloat64(v.ValueFloat64()), nil
+	case types.List:
+		return dynamicElementsToGo(v.Elements())
+	case types.Set:
+		return dynamicElementsToGo(v.Elements())
+	case types.Tuple:
+		return dynamicElementsToGo(v.Elements())
+	case types.Map:
+		return dynamicAttributesToGo(v.Elements())
+	case types.Object:
+		return dynamicAttributesToGo(v.Attributes())
+	default:
+		return nil, fmt.Errorf("unsupported value type %s", value.Type(nil))
+	}
+}
+
+// dynamicElementsToGo converts the elements of a list, set or tuple.
+func dynamicElementsToGo(elements []attr.Value) ([]any, error) {
+	result := make([]any, len(elements))
+	for i, element := range elements {
+		converted, err := dynamicToGo(element)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = converted
+	}
+	return result, nil
+}
+
+// dynamicAttributesToGo converts the attributes of an object or the elements of a map.
+func dynamicAttributesToGo(attributes map[string]attr.Value) (map[string]any, error) {
+	result := make(map[string]any, len(attributes))
+	for name, attribute := range attributes {
+		converted, err := dynamicToGo(attribute)
+		if err != nil {
+			return nil, err
+		}
+		result[name] = converted
+	}
+	return result, nil
+}
+
+// goObject asserts that a converted value is an object or map.
+func goObject(value any, path string) (map[string]any, error) {
+	object, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%s must be an object", path)
+	}
+	return object, nil
+}
+
+// goList asserts that a converted value is a list, set or tuple.
+func goList(value any, path string) ([]any, error) {
+	list, ok := value.([]any)
+	if !ok {
+		return nil, fmt.Errorf("%s must be a list", path)
+	}
+	return list, nil
+}
+
+// goString asserts that a converted value is a string.
+func goString(value any, path string) (string, error) {
+	str, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("%s must be a string", path)
+	}
+	return str, nil
+}
+
+// goStringList asserts that a converted value is a list of strings, a single string is accepted as a list of one.
+func goStringList(value any, path string) ([]string, error) {
+	if str, ok := value.(string); ok {
+		return []string{str}, nil
+	}
+	list, err := goList(value, path)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be a string or a list of strings", path)
+	}
+	result := make([]string, len(list))
+	for i, item := range list {
+		if result[i], err = goString(item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// goInt64 asserts that a converted value is a whole number.
+func goInt64(value any, path string) (int64, error) {
+	number, ok := value.(*big.Float)
+	if !ok || !number.IsInt() {
+		return 0, fmt.Errorf("%s must be a whole number", path)
+	}
+	result, accuracy := number.Int64()
+	if accuracy != big.Exact {
+		return 0, fmt.Errorf("%s is out of range", path)
+	}
+	return result, nil
+}
+
+// goBool asserts that a converted value is a bool.
+func goBool(value any, path string) (bool, error) {
+	b, ok := value.(bool)
+	if !ok {
+		return false, fmt.Errorf("%s must be a bool", path)
+	}
+	return b, nil
+}
+
+// checkObjectKeys returns an error listing the attributes of an object that are not allowed.
+func checkObjectKeys(object map[string]any, path string, allowed ...string) error {
+	var unknown []string
+	for key := range object {
+		found := false
+		for _, name := range allowed {
+			if key == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			unknown = append(unknown, key)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("%s has unsupported attributes: %v", path, unknown)
+	}
+	return nil
+}
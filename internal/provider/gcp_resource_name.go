@@ -0,0 +1,147 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Collections of GCP resource names with a meaning of their own.
+const (
+	gcpCollectionProjects      = "projects"
+	gcpCollectionOrganizations = "organizations"
+	gcpCollectionFolders       = "folders"
+	gcpSingletonGlobal         = "global"
+)
+
+// gcpLocationCollections are the collections whose IDs are locations.
+var gcpLocationCollections = []string{"locations", "regions", "zones"}
+
+// gcpPathCollections are the collections whose IDs are paths that can contain /, like the names of storage objects.
+// They are the last collection of a name, the rest of the name is their ID.
+var gcpPathCollections = []string{"objects", "documents"}
+
+// gcpAPIVersionRegexp matches the API versions in the path of self links like v1 and v1beta1.
+var gcpAPIVersionRegexp = regexp.MustCompile(`^v[0-9]+((alpha|beta)[0-9]*)?$`)
+
+// GCPResourceName holds the parts of a GCP resource name.
+type GCPResourceName struct {
+	Service      *string  `tfsdk:"service"`
+	RelativeName string   `tfsdk:"relative_name"`
+	Project      *string  `tfsdk:"project"`
+	Organization *string  `tfsdk:"organization"`
+	Folder       *string  `tfsdk:"folder"`
+	Location     *string  `tfsdk:"location"`
+	Collections  []string `tfsdk:"collections"`
+	IDs          []string `tfsdk:"ids"`
+	ResourceType string   `tfsdk:"resource_type"`
+	Name         string   `tfsdk:"name"`
+	Parent       *string  `tfsdk:"parent"`
+}
+
+// ParseGCPResourceName splits a GCP resource name into its collections and IDs.
+// The name is a relative name like projects/p/locations/l/keyRings/k, a full name with the service like //cloudkms.googleapis.com/projects/p/...,
+// or a self link like https://www.googleapis.com/compute/v1/projects/p/zones/z/instances/i.
+// The global segment of compute resource names is read as the location.
+// The ID of the objects of buckets and the documents of Firestore databases is the rest of the name, e.g. a/b/c in projects/_/buckets/b/objects/a/b/c.
+func ParseGCPResourceName(name string) (GCPResourceName, error) {
+	var result GCPResourceName
+	relativeName := name
+
+	switch {
+	case strings.HasPrefix(name, "//"):
+		service, rest, found := strings.Cut(name[2:], "/")
+		if !found || service == "" {
+			return GCPResourceName{}, fmt.Errorf("the full resource name %q must have the format //service/relative-name", name)
+		}
+		result.Service, relativeName = &service, rest
+	case strings.HasPrefix(name, "https://"):
+		host, rest, found := strings.Cut(strings.TrimPrefix(name, "https://"), "/")
+		if !found {
+			return GCPResourceName{}, fmt.Errorf("the self link %q has no resource name", name)
+		}
+		// Self links can have the API before the version, the API is the service of www.googleapis.com links
+		service := host
+		segments := strings.SplitN(rest, "/", 3)
+		if len(segments) == 3 && !gcpAPIVersionRegexp.MatchString(segments[0]) && gcpAPIVersionRegexp.MatchString(segments[1]) {
+			if host == "www.googleapis.com" {
+				service = segments[0] + ".googleapis.com"
+			}
+			segments = segments[1:]
+		} else {
+			segments = strings.SplitN(rest, "/", 2)
+		}
+		if len(segments) != 2 || !gcpAPIVersionRegexp.MatchString(segments[0]) {
+			return GCPResourceName{}, fmt.Errorf("the self link %q must have the format https://host/version/relative-name", name)
+		}
+		result.Service, relativeName = &service, segments[1]
+	}
+
+	relativeName = strings.Trim(relativeName, "/")
+	if relativeName == "" {
+		return GCPResourceName{}, fmt.Errorf("the resource name %q is empty", name)
+	}
+	segments := strings.Split(relativeName, "/")
+	last := 0
+	result.Collections, result.IDs = make([]string, 0), make([]string, 0)
+	for i := 0; i < len(segments); i += 2 {
+		collection := segments[i]
+		if collection == "" {
+			return GCPResourceName{}, fmt.Errorf("the resource name %q contains an empty segment", name)
+		}
+		if collection == gcpSingletonGlobal {
+			if i+1 == len(segments) {
+				return GCPResourceName{}, fmt.Errorf("the resource name %q has no collection after %s", name, gcpSingletonGlobal)
+			}
+			location := gcpSingletonGlobal
+			result.Location = &location
+			i--
+			continue
+		}
+		if i+1 == len(segments) {
+			return GCPResourceName{}, fmt.Errorf("the resource name %q has no ID after the collection %q", name, collection)
+		}
+		id := segments[i+1]
+		if slices.Contains(gcpPathCollections, collection) {
+			id = strings.Join(segments[i+1:], "/")
+		}
+		if id == "" {
+			return GCPResourceName{}, fmt.Errorf("the resource name %q contains an empty segment", name)
+		}
+
+		switch {
+		case collection == gcpCollectionProjects:
+			result.Project = &id
+		case collection == gcpCollectionOrganizations:
+			result.Organization = &id
+		case collection == gcpCollectionFolders:
+			result.Folder = &id
+		case slices.Contains(gcpLocationCollections, collection):
+			result.Location = &id
+		}
+		result.Collections = append(result.Collections, collection)
+		result.IDs = append(result.IDs, id)
+		last = i
+		if slices.Contains(gcpPathCollections, collection) {
+			break
+		}
+	}
+	if len(result.Collections) == 0 {
+		return GCPResourceName{}, fmt.Errorf("the resource name %q has no collections", name)
+	}
+
+	// The parent is the name without the last collection and ID
+	result.RelativeName = relativeName
+	result.ResourceType = result.Collections[len(result.Collections)-1]
+	result.Name = result.IDs[len(result.IDs)-1]
+	if last > 0 {
+		parent := strings.Join(segments[:last], "/")
+		result.Parent = &parent
+	}
+	return result, nil
+}
@@ -0,0 +1,95 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = GCPResourceNameParseFunction{}
+)
+
+// NewGCPResourceNameParseFunction is a helper function to create a new instance of GCPResourceNameParseFunction.
+func NewGCPResourceNameParseFunction() function.Function {
+	return GCPResourceNameParseFunction{}
+}
+
+// GCPResourceNameParseFunction is the struct for the GCP resource name parse function.
+type GCPResourceNameParseFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f GCPResourceNameParseFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "gcp_resource_name_parse"
+}
+
+// Definition sets the definition for the function.
+func (f GCPResourceNameParseFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse a GCP resource name",
+		MarkdownDescription: "Splits a GCP resource name into its `collections` and `ids`, e.g. `[\"projects\", \"locations\", \"keyRings\"]` and `[\"my-project\", \"europe-west1\", \"my-ring\"]`, " +
+			"and outputs the `project`, `organization`, `folder` and `location` found in the name, the `resource_type` and `name` of the last collection, " +
+			"the `parent` name without the last collection, null for top-level resources, and the `relative_name`. " +
+			"The location is the ID of the `locations`, `regions` or `zones` collection, or `global` for compute names like `projects/p/global/networks/n`. " +
+			"The ID of the `objects` of storage buckets and the `documents` of Firestore databases is the rest of the name, as it can contain `/`, " +
+			"e.g. `a/b/c` in `projects/_/buckets/b/objects/a/b/c`. " +
+			"The name can be a relative name, a full resource name like `//cloudkms.googleapis.com/projects/...` " +
+			"or a self link like `https://www.googleapis.com/compute/v1/projects/...`, the `service` is output for the last two and null otherwise.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "The GCP resource name, full resource name or self link to parse",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"service":       types.StringType,
+				"relative_name": types.StringType,
+				"project":       types.StringType,
+				"organization":  types.StringType,
+				"folder":        types.StringType,
+				"location":      types.StringType,
+				"collections":   types.ListType{ElemType: types.StringType},
+				"ids":           types.ListType{ElemType: types.StringType},
+				"resource_type": types.StringType,
+				"name":          types.StringType,
+				"parent":        types.StringType,
+			},
+		},
+	}
+}
+
+// Run executes the GCP resource name parse function.
+func (f GCPResourceNameParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+
+	// Parse the argument
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input argument
+	if name == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The name argument must be provided and valid"))
+		return
+	}
+
+	// Parse the resource name
+	parsed, err := ParseGCPResourceName(name)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error parsing GCP resource name: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, parsed))
+}
@@ -0,0 +1,138 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestGCPResourceNameParseFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		result    string
+	}{
+		"crypto-key": {
+			arguments: `"projects/my-project/locations/europe-west1/keyRings/my-ring/cryptoKeys/my-key"`,
+			result:    `{"collections":["projects","locations","keyRings","cryptoKeys"],"folder":null,"ids":["my-project","europe-west1","my-ring","my-key"],"location":"europe-west1","name":"my-key","organization":null,"parent":"projects/my-project/locations/europe-west1/keyRings/my-ring","project":"my-project","relative_name":"projects/my-project/locations/europe-west1/keyRings/my-ring/cryptoKeys/my-key","resource_type":"cryptoKeys","service":null}`,
+		},
+		"full-name": {
+			arguments: `"//cloudkms.googleapis.com/projects/my-project/locations/europe-west1/keyRings/my-ring/cryptoKeys/my-key"`,
+			result:    `{"collections":["projects","locations","keyRings","cryptoKeys"],"folder":null,"ids":["my-project","europe-west1","my-ring","my-key"],"location":"europe-west1","name":"my-key","organization":null,"parent":"projects/my-project/locations/europe-west1/keyRings/my-ring","project":"my-project","relative_name":"projects/my-project/locations/europe-west1/keyRings/my-ring/cryptoKeys/my-key","resource_type":"cryptoKeys","service":"cloudkms.googleapis.com"}`,
+		},
+		"self-link": {
+			arguments: `"https://www.googleapis.com/compute/v1/projects/my-project/zones/europe-west1-b/instances/web-1"`,
+			result:    `{"collections":["projects","zones","instances"],"folder":null,"ids":["my-project","europe-west1-b","web-1"],"location":"europe-west1-b","name":"web-1","organization":null,"parent":"projects/my-project/zones/europe-west1-b","project":"my-project","relative_name":"projects/my-project/zones/europe-west1-b/instances/web-1","resource_type":"instances","service":"compute.googleapis.com"}`,
+		},
+		"self-link-api-host": {
+			arguments: `"https://container.googleapis.com/v1beta1/projects/my-project/locations/europe-west1/clusters/gke-1"`,
+			result:    `{"collections":["projects","locations","clusters"],"folder":null,"ids":["my-project","europe-west1","gke-1"],"location":"europe-west1","name":"gke-1","organization":null,"parent":"projects/my-project/locations/europe-west1","project":"my-project","relative_name":"projects/my-project/locations/europe-west1/clusters/gke-1","resource_type":"clusters","service":"container.googleapis.com"}`,
+		},
+		"global": {
+			arguments: `"projects/my-project/global/networks/vpc-hub"`,
+			result:    `{"collections":["projects","networks"],"folder":null,"ids":["my-project","vpc-hub"],"location":"global","name":"vpc-hub","organization":null,"parent":"projects/my-project/global","project":"my-project","relative_name":"projects/my-project/global/networks/vpc-hub","resource_type":"networks","service":null}`,
+		},
+		"subnetwork": {
+			arguments: `"projects/my-project/regions/europe-west1/subnetworks/snet-app"`,
+			result:    `{"collections":["projects","regions","subnetworks"],"folder":null,"ids":["my-project","europe-west1","snet-app"],"location":"europe-west1","name":"snet-app","organization":null,"parent":"projects/my-project/regions/europe-west1","project":"my-project","relative_name":"projects/my-project/regions/europe-west1/subnetworks/snet-app","resource_type":"subnetworks","service":null}`,
+		},
+		"folder": {
+			arguments: `"organizations/123456789/folders/987654321"`,
+			result:    `{"collections":["organizations","folders"],"folder":"987654321","ids":["123456789","987654321"],"location":null,"name":"987654321","organization":"123456789","parent":"organizations/123456789","project":null,"relative_name":"organizations/123456789/folders/987654321","resource_type":"folders","service":null}`,
+		},
+		"storage-object": {
+			arguments: `"projects/_/buckets/b/objects/a/b/c"`,
+			result:    `{"collections":["projects","buckets","objects"],"folder":null,"ids":["_","b","a/b/c"],"location":null,"name":"a/b/c","organization":null,"parent":"projects/_/buckets/b","project":"_","relative_name":"projects/_/buckets/b/objects/a/b/c","resource_type":"objects","service":null}`,
+		},
+		"storage-object-full-name": {
+			arguments: `"//storage.googleapis.com/projects/_/buckets/b/objects/logs/global/app.log"`,
+			result:    `{"collections":["projects","buckets","objects"],"folder":null,"ids":["_","b","logs/global/app.log"],"location":null,"name":"logs/global/app.log","organization":null,"parent":"projects/_/buckets/b","project":"_","relative_name":"projects/_/buckets/b/objects/logs/global/app.log","resource_type":"objects","service":"storage.googleapis.com"}`,
+		},
+		"firestore-document": {
+			arguments: `"projects/my-project/databases/(default)/documents/users/alice"`,
+			result:    `{"collections":["projects","databases","documents"],"folder":null,"ids":["my-project","(default)","users/alice"],"location":null,"name":"users/alice","organization":null,"parent":"projects/my-project/databases/(default)","project":"my-project","relative_name":"projects/my-project/databases/(default)/documents/users/alice","resource_type":"documents","service":null}`,
+		},
+		"project": {
+			arguments: `"projects/my-project"`,
+			result:    `{"collections":["projects"],"folder":null,"ids":["my-project"],"location":null,"name":"my-project","organization":null,"parent":null,"project":"my-project","relative_name":"projects/my-project","resource_type":"projects","service":null}`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::gcp_resource_name_parse(%s))
+							}
+						`, testCase.arguments),
+						Check: resource.TestCheckOutput("result", testCase.result),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestGCPResourceNameParseFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		error     string
+	}{
+		"no-id": {
+			arguments: `"projects/my-project/locations"`,
+			error:     `(?s)Call to function "provider::iactools::gcp_resource_name_parse" failed.*Error\s+parsing\s+GCP\s+resource\s+name:\s+the\s+resource\s+name\s+"projects/my-project/locations"\s+has\s+no\s+ID\s+after\s+the\s+collection\s+"locations"`,
+		},
+		"empty-segment": {
+			arguments: `"projects//zones/z"`,
+			error:     `(?s)Call to function "provider::iactools::gcp_resource_name_parse" failed.*Error\s+parsing\s+GCP\s+resource\s+name:\s+the\s+resource\s+name\s+"projects//zones/z"\s+contains\s+an\s+empty\s+segment`,
+		},
+		"trailing-global": {
+			arguments: `"projects/my-project/global"`,
+			error:     `(?s)Call to function "provider::iactools::gcp_resource_name_parse" failed.*Error\s+parsing\s+GCP\s+resource\s+name:\s+the\s+resource\s+name\s+"projects/my-project/global"\s+has\s+no\s+collection\s+after\s+global`,
+		},
+		"full-name-without-service": {
+			arguments: `"///projects/p"`,
+			error:     `(?s)Call to function "provider::iactools::gcp_resource_name_parse" failed.*Error\s+parsing\s+GCP\s+resource\s+name:\s+the\s+full\s+resource\s+name\s+"///projects/p"\s+must\s+have\s+the\s+format\s+//service/relative-name`,
+		},
+		"self-link-without-version": {
+			arguments: `"https://www.googleapis.com/projects/p"`,
+			error:     `(?s)Call to function "provider::iactools::gcp_resource_name_parse" failed.*Error\s+parsing\s+GCP\s+resource\s+name:\s+the\s+self\s+link\s+"https://www.googleapis.com/projects/p"\s+must\s+have\s+the\s+format\s+https://host/version/relative-name`,
+		},
+		"empty": {
+			arguments: `""`,
+			error:     `(?s)Call to function "provider::iactools::gcp_resource_name_parse" failed.*The\s+name\s+argument\s+must\s+be\s+provided\s+and\s+valid`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::gcp_resource_name_parse(%s)
+							}
+						`, testCase.arguments),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
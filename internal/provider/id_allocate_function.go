@@ -0,0 +1,206 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = IDAllocateFunction{}
+)
+
+// NewIDAllocateFunction is a helper function to create a new instance of IDAllocateFunction.
+func NewIDAllocateFunction() function.Function {
+	return IDAllocateFunction{}
+}
+
+// IDAllocateFunction is the struct for the ID allocate function.
+type IDAllocateFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f IDAllocateFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "id_allocate"
+}
+
+// Definition sets the definition for the function.
+func (f IDAllocateFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Allocate IDs like VLAN IDs and VXLAN VNIs from integer ranges",
+		MarkdownDescription: "Allocates an ID or a contiguous block of IDs to every request from the pool ranges, skipping the reserved IDs, " +
+			"and outputs a map of the allocations by request name with the first `id`, the `last` ID and the `size` of the block. " +
+			"Requests pinned to an `id` keep it, requests in `existing` keep their previous ID while its block is still free, " +
+			"and the other requests get the lowest free block of their size in the order of their names, so the result doesn't depend on the order of the requests. " +
+			"Pass the previous allocations, e.g. the output of the last run kept in a Terraform output or read from the deployed resources, as `existing` " +
+			"to keep the IDs stable when requests are added or removed: new requests take free IDs and the IDs of removed requests become free, " +
+			"without moving the other requests. Without `existing` the free IDs are allocated from the start of the pool on every call, " +
+			"so adding or removing a request renumbers the requests after it in name order. " +
+			"The function fails when a pinned block isn't free, or when the pool has no free block large enough for a request.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "pool_ranges",
+				ElementType:         types.StringType,
+				MarkdownDescription: "The list of IDs and ID ranges to allocate from, like `[\"100-199\", \"300\"]`",
+			},
+			function.DynamicParameter{
+				Name: "requests",
+				MarkdownDescription: "The map of requests by name, each the number of IDs to allocate, " +
+					"or an object with the optional attributes `size` (defaults to 1) and `id` (the first ID to pin the block to)",
+			},
+			function.ListParameter{
+				Name:                "reserved",
+				ElementType:         types.StringType,
+				MarkdownDescription: "The list of IDs and ID ranges not to allocate, like `[\"1\", \"1002-1005\"]`. Can be null.",
+				AllowNullValue:      true,
+			},
+			function.DynamicParameter{
+				Name: "existing",
+				MarkdownDescription: "The map of previously allocated IDs by request name, each the first ID or an allocation object with the `id` attribute, " +
+					"like the output of a previous call. Can be null.",
+				AllowNullValue: true,
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"id":   types.Int64Type,
+					"last": types.Int64Type,
+					"size": types.Int64Type,
+				},
+			},
+		},
+	}
+}
+
+// Run executes the ID allocate function.
+func (f IDAllocateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var poolRanges, reserved []string
+	var requestsArgument, existingArgument types.Dynamic
+
+	// Parse the arguments
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &poolRanges, &requestsArgument, &reserved, &existingArgument))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input arguments
+	if len(poolRanges) == 0 {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The pool_ranges argument must be provided and valid"))
+		return
+	}
+	requests, err := parseIDRequests(requestsArgument)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error parsing requests: %s", err.Error())))
+		return
+	}
+	existing, err := parseExistingIDs(existingArgument)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error parsing existing: %s", err.Error())))
+		return
+	}
+
+	// Allocate the IDs
+	allocations, err := IDAllocate(poolRanges, requests, reserved, existing)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error allocating IDs: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, allocations))
+}
+
+// parseIDRequests converts the requests argument into ID requests.
+func parseIDRequests(value types.Dynamic) ([]IDRequest, error) {
+	converted, err := dynamicToGo(value)
+	if err != nil || converted == nil {
+		return nil, err
+	}
+	objects, err := goObject(converted, "requests")
+	if err != nil {
+		return nil, fmt.Errorf("requests must be a map of sizes or request objects by name")
+	}
+
+	names := make([]string, 0, len(objects))
+	for name := range objects {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	requests := make([]IDRequest, 0, len(objects))
+	for _, name := range names {
+		path := fmt.Sprintf("requests[%q]", name)
+		request := IDRequest{Name: name, Size: 1}
+		switch item := objects[name].(type) {
+		case *big.Float:
+			if request.Size, err = goInt64(item, path); err != nil {
+				return nil, err
+			}
+		case map[string]any:
+			if err := checkObjectKeys(item, path, "size", "id"); err != nil {
+				return nil, err
+			}
+			if item["size"] != nil {
+				if request.Size, err = goInt64(item["size"], path+".size"); err != nil {
+					return nil, err
+				}
+			}
+			if item["id"] != nil {
+				id, err := goInt64(item["id"], path+".id")
+				if err != nil {
+					return nil, err
+				}
+				request.ID = &id
+			}
+		default:
+			return nil, fmt.Errorf("%s must be a size or an object with size and id", path)
+		}
+		requests = append(requests, request)
+	}
+	return requests, nil
+}
+
+// parseExistingIDs converts the existing argument into the previously allocated IDs by request name.
+func parseExistingIDs(value types.Dynamic) (map[string]int64, error) {
+	converted, err := dynamicToGo(value)
+	if err != nil || converted == nil {
+		return nil, err
+	}
+	objects, err := goObject(converted, "existing")
+	if err != nil {
+		return nil, fmt.Errorf("existing must be a map of IDs or allocation objects by name")
+	}
+
+	existing := make(map[string]int64, len(objects))
+	for name, item := range objects {
+		path := fmt.Sprintf("existing[%q]", name)
+		if object, ok := item.(map[string]any); ok {
+			if err := checkObjectKeys(object, path, "id", "last", "size"); err != nil {
+				return nil, err
+			}
+			if object["id"] == nil {
+				return nil, fmt.Errorf("%s.id must be provided", path)
+			}
+			item, path = object["id"], path+".id"
+		}
+		id, err := goInt64(item, path)
+		if err != nil {
+			return nil, err
+		}
+		if id < 0 || id > idMax {
+			return nil, fmt.Errorf("%s must be between 0 and %d", path, int64(idMax))
+		}
+		existing[name] = id
+	}
+	return existing, nil
+}
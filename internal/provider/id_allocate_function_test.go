@@ -0,0 +1,210 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestIDAllocateFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		result    string
+	}{
+		"vlans": {
+			arguments: `["1-20"], { web = 1, app = 1, db = 1 }, ["1", "3"], null`,
+			result:    `{"app":{"id":2,"last":2,"size":1},"db":{"id":4,"last":4,"size":1},"web":{"id":5,"last":5,"size":1}}`,
+		},
+		"blocks": {
+			arguments: `["100-199"], { tenant-a = 8, tenant-b = { size = 4 }, tenant-c = 1 }, null, null`,
+			result:    `{"tenant-a":{"id":100,"last":107,"size":8},"tenant-b":{"id":108,"last":111,"size":4},"tenant-c":{"id":112,"last":112,"size":1}}`,
+		},
+		"pinned": {
+			arguments: `["100-199"], { a = 1, b = { id = 100 }, c = { id = 150, size = 2 } }, null, null`,
+			result:    `{"a":{"id":101,"last":101,"size":1},"b":{"id":100,"last":100,"size":1},"c":{"id":150,"last":151,"size":2}}`,
+		},
+		"pinned-existing-with-earlier-name": {
+			arguments: `["1-20"], { aaa = 1, app = { id = 2 }, db = { id = 4 }, web = { id = 5 } }, ["1", "3"], null`,
+			result:    `{"aaa":{"id":6,"last":6,"size":1},"app":{"id":2,"last":2,"size":1},"db":{"id":4,"last":4,"size":1},"web":{"id":5,"last":5,"size":1}}`,
+		},
+		"existing": {
+			arguments: `["1-20"], { aaa = 1, app = 1, db = 1, web = 1 }, ["1", "3"], { app = 2, db = { id = 4, last = 4, size = 1 }, web = 5, gone = 6 }`,
+			result:    `{"aaa":{"id":6,"last":6,"size":1},"app":{"id":2,"last":2,"size":1},"db":{"id":4,"last":4,"size":1},"web":{"id":5,"last":5,"size":1}}`,
+		},
+		"existing-not-free": {
+			arguments: `["1-20"], { a = { id = 10 }, b = 1, c = 2 }, ["2"], { b = 10, c = 1 }`,
+			result:    `{"a":{"id":10,"last":10,"size":1},"b":{"id":1,"last":1,"size":1},"c":{"id":3,"last":4,"size":2}}`,
+		},
+		"block-skips-reserved": {
+			arguments: `["1000-1010"], { a = 4, b = 2 }, ["1002-1005"], null`,
+			result:    `{"a":{"id":1006,"last":1009,"size":4},"b":{"id":1000,"last":1001,"size":2}}`,
+		},
+		"vni": {
+			arguments: `[10000, "20000-20999"], { blue = 2, red = 1 }, null, null`,
+			result:    `{"blue":{"id":20000,"last":20001,"size":2},"red":{"id":10000,"last":10000,"size":1}}`,
+		},
+		"no-requests": {
+			arguments: `["1-10"], {}, null, null`,
+			result:    `{}`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::id_allocate(%s))
+							}
+						`, testCase.arguments),
+						Check: resource.TestCheckOutput("result", testCase.result),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestIDAllocateFunction_Existing(t *testing.T) {
+	testCases := map[string]struct {
+		before string
+		after  string
+		ids    string
+	}{
+		"add-a-name-that-sorts-first": {
+			before: `{ app = 1, db = 1, web = 1 }`,
+			after:  `{ aaa = 1, app = 1, db = 1, web = 1 }`,
+			ids:    `{"aaa":6,"app":2,"db":4,"web":5}`,
+		},
+		"remove-a-name": {
+			before: `{ app = 1, db = 1, web = 1 }`,
+			after:  `{ db = 1, web = 1 }`,
+			ids:    `{"db":4,"web":5}`,
+		},
+		"replace-a-name": {
+			before: `{ app = 1, db = 1, web = 1 }`,
+			after:  `{ app = 1, new = 2, web = 1 }`,
+			ids:    `{"app":2,"new":6,"web":5}`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							locals {
+								before = provider::iactools::id_allocate(["1-20"], %s, ["1", "3"], null)
+								after  = provider::iactools::id_allocate(["1-20"], %s, ["1", "3"], local.before)
+							}
+
+							output "result" {
+								value = jsonencode({ for name, allocation in local.after : name => allocation.id })
+							}
+
+							output "unchanged" {
+								value = alltrue([for name, allocation in local.before : local.after[name].id == allocation.id if contains(keys(local.after), name)])
+							}
+						`, testCase.before, testCase.after),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckOutput("result", testCase.ids),
+							resource.TestCheckOutput("unchanged", "true"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestIDAllocateFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		error     string
+	}{
+		"empty-pool": {
+			arguments: `[], { a = 1 }, null, null`,
+			error:     `(?s)Call to function "provider::iactools::id_allocate" failed.*The\s+pool_ranges\s+argument\s+must\s+be\s+provided\s+and\s+valid`,
+		},
+		"invalid-pool-range": {
+			arguments: `["200-100"], { a = 1 }, null, null`,
+			error:     `(?s)Call to function "provider::iactools::id_allocate" failed.*invalid\s+pool\s+range:\s+"200-100":\s+start\s+must\s+not\s+be\s+greater\s+than\s+end`,
+		},
+		"exhausted": {
+			arguments: `["1-10"], { a = 6, b = 6 }, null, null`,
+			error:     `(?s)Call to function "provider::iactools::id_allocate" failed.*the\s+pool\s+is\s+exhausted:\s+request\s+"b"\s+needs\s+6\s+IDs,\s+4\s+IDs\s+left,\s+the\s+largest\s+free\s+block\s+has\s+4\s+IDs`,
+		},
+		"fragmented": {
+			arguments: `["1-10"], { a = 4 }, ["4", "8"], null`,
+			error:     `(?s)Call to function "provider::iactools::id_allocate" failed.*request\s+"a"\s+needs\s+4\s+IDs,\s+8\s+IDs\s+left,\s+the\s+largest\s+free\s+block\s+has\s+3\s+IDs`,
+		},
+		"pinned-reserved": {
+			arguments: `["1-4094"], { a = { id = 1 } }, ["1"], null`,
+			error:     `(?s)Call to function "provider::iactools::id_allocate" failed.*ID\s+1\s+of\s+request\s+"a"\s+is\s+not\s+available:\s+it\s+overlaps\s+the\s+reserved\s+IDs`,
+		},
+		"pinned-outside-pool": {
+			arguments: `["100-199"], { a = { id = 195, size = 10 } }, null, null`,
+			error:     `(?s)Call to function "provider::iactools::id_allocate" failed.*IDs\s+195-204\s+of\s+request\s+"a"\s+is\s+not\s+available:\s+it\s+is\s+outside\s+of\s+the\s+pool`,
+		},
+		"pinned-overlap": {
+			arguments: `["100-199"], { a = { id = 100, size = 4 }, b = { id = 102 } }, null, null`,
+			error:     `(?s)Call to function "provider::iactools::id_allocate" failed.*ID\s+102\s+of\s+request\s+"b"\s+is\s+not\s+available:\s+it\s+overlaps\s+request\s+"a"`,
+		},
+		"invalid-existing": {
+			arguments: `["1-10"], { a = 1 }, null, ["1"]`,
+			error:     `(?s)Call to function "provider::iactools::id_allocate" failed.*Error\s+parsing\s+existing:\s+existing\s+must\s+be\s+a\s+map\s+of\s+IDs\s+or\s+allocation\s+objects\s+by\s+name`,
+		},
+		"existing-without-id": {
+			arguments: `["1-10"], { a = 1 }, null, { a = { size = 1 } }`,
+			error:     `(?s)Call to function "provider::iactools::id_allocate" failed.*existing\["a"\]\.id\s+must\s+be\s+provided`,
+		},
+		"existing-out-of-range": {
+			arguments: `["1-10"], { a = 1 }, null, { a = -1 }`,
+			error:     `(?s)Call to function "provider::iactools::id_allocate" failed.*existing\["a"\]\s+must\s+be\s+between\s+0\s+and\s+4294967295`,
+		},
+		"invalid-request": {
+			arguments: `["1-10"], { a = "one" }, null, null`,
+			error:     `(?s)Call to function "provider::iactools::id_allocate" failed.*requests\["a"\]\s+must\s+be\s+a\s+size\s+or\s+an\s+object\s+with\s+size\s+and\s+id`,
+		},
+		"zero-size": {
+			arguments: `["1-10"], { a = 0 }, null, null`,
+			error:     `(?s)Call to function "provider::iactools::id_allocate" failed.*size\s+of\s+request\s+"a"\s+must\s+be\s+between\s+1\s+and\s+4294967295`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::id_allocate(%s)
+							}
+						`, testCase.arguments),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// idMax is the largest ID the allocator accepts, enough for VLAN IDs, VXLAN VNIs and 4-byte AS numbers.
+const idMax = 1<<32 - 1
+
+// IDRequest holds a request for an ID or a contiguous block of IDs, optionally pinned to its first ID.
+type IDRequest struct {
+	Name string
+	Size int64
+	ID   *int64
+}
+
+// IDAllocation holds the allocated ID, or the first and last ID of the allocated block.
+type IDAllocation struct {
+	ID   int64 `tfsdk:"id"`
+	Last int64 `tfsdk:"last"`
+	Size int64 `tfsdk:"size"`
+}
+
+// IDAllocate allocates IDs like VLAN IDs or VXLAN VNIs from the pool ranges, skipping the reserved IDs.
+// Pinned requests keep their IDs, and requests with an existing ID keep it while its block is still free,
+// the other requests get the lowest free block of their size in the order of their names,
+// so the allocations only depend on the requests, not on the order they are listed in.
+func IDAllocate(poolRanges []string, requests []IDRequest, reservedRanges []string, existing map[string]int64) (map[string]IDAllocation, error) {
+	pool, err := parseIDRanges(poolRanges)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pool range: %v", err)
+	}
+	if len(pool) == 0 {
+		return nil, fmt.Errorf("the pool has no IDs")
+	}
+	reserved, err := parseIDRanges(reservedRanges)
+	if err != nil {
+		return nil, fmt.Errorf("invalid reserved range: %v", err)
+	}
+
+	requests = append([]IDRequest(nil), requests...)
+	sort.SliceStable(requests, func(i, j int) bool {
+		if (requests[i].ID != nil) != (requests[j].ID != nil) {
+			return requests[i].ID != nil
+		}
+		return requests[i].Name < requests[j].Name
+	})
+
+	free := subtractIntRanges(pool, reserved)
+	allocations := make(map[string]IDAllocation, len(requests))
+	owners := make(map[IntRange]string, len(requests))
+	allocate := func(request IDRequest, block IntRange) {
+		free = subtractIntRanges(free, []IntRange{block})
+		owners[block] = request.Name
+		allocations[request.Name] = IDAllocation{ID: block.From, Last: block.To, Size: request.Size}
+	}
+
+	// Pinned requests first, then the requests keeping their existing IDs
+	var pending []IDRequest
+	names := make(map[string]bool, len(requests))
+	for _, request := range requests {
+		if request.Name == "" {
+			return nil, fmt.Errorf("request names must not be empty")
+		}
+		if names[request.Name] {
+			return nil, fmt.Errorf("duplicate request name %q", request.Name)
+		}
+		names[request.Name] = true
+		if request.Size < 1 || request.Size > idMax {
+			return nil, fmt.Errorf("size of request %q must be between 1 and %d", request.Name, int64(idMax))
+		}
+		if request.ID != nil && (*request.ID < 0 || *request.ID > idMax) {
+			return nil, fmt.Errorf("ID of request %q must be between 0 and %d", request.Name, int64(idMax))
+		}
+
+		if request.ID != nil {
+			block := IntRange{From: *request.ID, To: *request.ID + request.Size - 1}
+			if !intRangesContain(free, []IntRange{block}) {
+				return nil, fmt.Errorf("%s of request %q is not available: %s", formatIDRange(block), request.Name, idRangeConflict(block, pool, reserved, owners))
+			}
+			allocate(request, block)
+			continue
+		}
+		pending = append(pending, request)
+	}
+	var unallocated []IDRequest
+	for _, request := range pending {
+		if id, ok := existing[request.Name]; ok {
+			if block := (IntRange{From: id, To: id + request.Size - 1}); intRangesContain(free, []IntRange{block}) {
+				allocate(request, block)
+				continue
+			}
+		}
+		unallocated = append(unallocated, request)
+	}
+
+	// The other requests get the lowest free block of their size
+	for _, request := range unallocated {
+		found := false
+		for _, r := range free {
+			if r.To-r.From+1 >= request.Size {
+				allocate(request, IntRange{From: r.From, To: r.From + request.Size - 1})
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("the pool is exhausted: request %q needs %s, %s", request.Name, formatIDCount(request.Size), idPoolUsage(free))
+		}
+	}
+	return allocations, nil
+}
+
+// Helper functions
+
+// parseIDRanges parses and merges lists of IDs and ID ranges like "100-199,300".
+func parseIDRanges(specs []string) ([]IntRange, error) {
+	var ranges []IntRange
+	for _, spec := range specs {
+		for _, part := range strings.Split(spec, ",") {
+			part = strings.TrimSpace(part)
+			if part == "" {
+				continue
+			}
+
+			from, to, isRange := strings.Cut(part, "-")
+			first, err := parseID(from)
+			if err != nil {
+				return nil, fmt.Errorf("%q: %v", part, err)
+			}
+			last := first
+			if isRange {
+				if last, err = parseID(to); err != nil {
+					return nil, fmt.Errorf("%q: %v", part, err)
+				}
+			}
+			if first > last {
+				return nil, fmt.Errorf("%q: start must not be greater than end", part)
+			}
+			ranges = append(ranges, IntRange{From: first, To: last})
+		}
+	}
+	return normalizeIntRanges(ranges), nil
+}
+
+// parseID parses a single non-negative ID.
+func parseID(value string) (int64, error) {
+	id, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+	if err != nil || id < 0 || id > idMax {
+		return 0, fmt.Errorf("IDs must be integers between 0 and %d", int64(idMax))
+	}
+	return id, nil
+}
+
+// idRangeConflict explains why a pinned block isn't free.
+func idRangeConflict(block IntRange, pool, reserved []IntRange, owners map[IntRange]string) string {
+	if !intRangesContain(pool, []IntRange{block}) {
+		return "it is outside of the pool"
+	}
+	if len(intersectIntRanges([]IntRange{block}, reserved)) > 0 {
+		return "it overlaps the reserved IDs"
+	}
+
+	var names []string
+	for owner, name := range owners {
+		if owner.From <= block.To && owner.To >= block.From {
+			names = append(names, fmt.Sprintf("%q", name))
+		}
+	}
+	sort.Strings(names)
+	return fmt.Sprintf("it overlaps request %s", strings.Join(names, ", "))
+}
+
+// idPoolUsage describes the free IDs of the pool for the exhaustion errors.
+func idPoolUsage(free []IntRange) string {
+	var total, largest int64
+	for _, r := range free {
+		total += r.To - r.From + 1
+		largest = max(largest, r.To-r.From+1)
+	}
+	if total == 0 {
+		return "no IDs are left"
+	}
+	return fmt.Sprintf("%s left, the largest free block has %s", formatIDCount(total), formatIDCount(largest))
+}
+
+// formatIDRange formats an ID or an ID range.
+func formatIDRange(r IntRange) string {
+	if r.From == r.To {
+		return fmt.Sprintf("ID %d", r.From)
+	}
+	return fmt.Sprintf("IDs %d-%d", r.From, r.To)
+}
+
+// formatIDCount formats a number of IDs.
+func formatIDCount(count int64) string {
+	if count == 1 {
+		return "1 ID"
+	}
+	return fmt.Sprintf("%d IDs", count)
+}
@@ -0,0 +1,80 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "sort"
+
+// IntRange holds an inclusive range of integers like port numbers or IDs.
+type IntRange struct {
+	From int64
+	To   int64
+}
+
+// normalizeIntRanges sorts the ranges and merges the overlapping and adjacent ones.
+func normalizeIntRanges(ranges []IntRange) []IntRange {
+	sorted := make([]IntRange, len(ranges))
+	copy(sorted, ranges)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].From != sorted[j].From {
+			return sorted[i].From < sorted[j].From
+		}
+		return sorted[i].To < sorted[j].To
+	})
+
+	merged := make([]IntRange, 0, len(sorted))
+	for _, r := range sorted {
+		if last := len(merged) - 1; last >= 0 && r.From <= merged[last].To+1 {
+			merged[last].To = max(merged[last].To, r.To)
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+// subtractIntRanges returns the integers of the first set that are not in the second set, both sets must be normalized.
+func subtractIntRanges(ranges, remove []IntRange) []IntRange {
+	result := make([]IntRange, 0, len(ranges))
+	for _, r := range ranges {
+		for _, cut := range remove {
+			if cut.To < r.From || cut.From > r.To {
+				continue
+			}
+			if cut.From > r.From {
+				result = append(result, IntRange{From: r.From, To: cut.From - 1})
+			}
+			r.From = cut.To + 1
+			if r.From > r.To {
+				break
+			}
+		}
+		if r.From <= r.To {
+			result = append(result, r)
+		}
+	}
+	return result
+}
+
+// intersectIntRanges returns the integers that are in both sets, both sets must be normalized.
+func intersectIntRanges(a, b []IntRange) []IntRange {
+	result := make([]IntRange, 0)
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		from, to := max(a[i].From, b[j].From), min(a[i].To, b[j].To)
+		if from <= to {
+			result = append(result, IntRange{From: from, To: to})
+		}
+		if a[i].To < b[j].To {
+			i++
+		} else {
+			j++
+		}
+	}
+	return result
+}
+
+// intRangesContain reports whether the outer set contains every integer of the inner set, both sets must be normalized.
+func intRangesContain(outer, inner []IntRange) bool {
+	return len(subtractIntRanges(inner, outer)) == 0
+}
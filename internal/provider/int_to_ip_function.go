@@ -0,0 +1,80 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = IntToIPFunction{}
+)
+
+// NewIntToIPFunction is a helper function to create a new instance of IntToIPFunction.
+func NewIntToIPFunction() function.Function {
+	return IntToIPFunction{}
+}
+
+// IntToIPFunction is the struct for the integer to IP function.
+type IntToIPFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f IntToIPFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "int_to_ip"
+}
+
+// Definition sets the definition for the function.
+func (f IntToIPFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Convert an integer into an IP address",
+		MarkdownDescription: "Outputs the IPv4 or IPv6 address of an integer, the reverse of `ip_to_int`. " +
+			"The integer is a decimal string, numbers are converted to strings by Terraform. " +
+			"The function fails when the integer is outside of the address range of the IP version.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "value",
+				MarkdownDescription: "The integer value of the address as a decimal string",
+			},
+			function.Int64Parameter{
+				Name:                "ip_version",
+				MarkdownDescription: "The IP version of the address: 4 or 6",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run executes the integer to IP function.
+func (f IntToIPFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var value string
+	var ipVersion int64
+
+	// Parse the arguments
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &value, &ipVersion))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input arguments
+	if value == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The value argument must be provided and valid"))
+		return
+	}
+
+	// Convert the integer
+	result, err := IntToIP(value, ipVersion)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error converting integer to IP address: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.StringValue(result)))
+}
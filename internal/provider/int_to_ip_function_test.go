@@ -0,0 +1,106 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestIntToIPFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		result    string
+	}{
+		"ipv4": {
+			arguments: `"167772161", 4`,
+			result:    `"10.0.0.1"`,
+		},
+		"ipv4-number": {
+			arguments: `167772161, 4`,
+			result:    `"10.0.0.1"`,
+		},
+		"ipv6": {
+			arguments: `"42540766411282592856903984951653826560", 6`,
+			result:    `"2001:db8::"`,
+		},
+		"ipv6-zero": {
+			arguments: `"0", 6`,
+			result:    `"::"`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::int_to_ip(%s))
+							}
+						`, testCase.arguments),
+						Check: resource.TestCheckOutput("result", testCase.result),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestIntToIPFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		error     string
+	}{
+		"empty-value": {
+			arguments: `"", 4`,
+			error:     `(?s)Call to function "provider::iactools::int_to_ip" failed.*The\s+value\s+argument\s+must\s+be\s+provided\s+and\s+valid`,
+		},
+		"invalid-integer": {
+			arguments: `"0x0a000001", 4`,
+			error:     `(?s)Call to function "provider::iactools::int_to_ip" failed.*invalid\s+integer\s+"0x0a000001"`,
+		},
+		"out-of-range": {
+			arguments: `"4294967296", 4`,
+			error:     `(?s)Call to function "provider::iactools::int_to_ip" failed.*4294967296\s+is\s+outside\s+of\s+the\s+IPv4\s+address\s+range`,
+		},
+		"negative": {
+			arguments: `"-1", 6`,
+			error:     `(?s)Call to function "provider::iactools::int_to_ip" failed.*-1\s+is\s+outside\s+of\s+the\s+IPv6\s+address\s+range`,
+		},
+		"invalid-version": {
+			arguments: `"1", 5`,
+			error:     `(?s)Call to function "provider::iactools::int_to_ip" failed.*invalid\s+IP\s+version\s+5:\s+must\s+be\s+4\s+or\s+6`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::int_to_ip(%s)
+							}
+						`, testCase.arguments),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
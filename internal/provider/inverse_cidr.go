@@ -92,6 +92,29 @@ func findInverseCIDRs(parentCIDR, childCIDR *net.IPNet) ([]*net.IPNet, bool) {
 	return nil, false
 }
 
+// findFreeCIDRs returns the networks of the parent not covered by any of the used networks, in address order.
+// It generalizes findInverseCIDRs to many children.
+func findFreeCIDRs(parentCIDR *net.IPNet, usedCIDRs []*net.IPNet) []*net.IPNet {
+	var overlapping []*net.IPNet
+	for _, used := range usedCIDRs {
+		if ipNetContains(used, parentCIDR) {
+			return nil
+		}
+		if ipNetContains(parentCIDR, used) {
+			overlapping = append(overlapping, used)
+		}
+	}
+	if len(overlapping) == 0 {
+		return []*net.IPNet{parentCIDR}
+	}
+
+	subnets, err := splitCIDR(parentCIDR)
+	if err != nil {
+		return nil
+	}
+	return append(findFreeCIDRs(subnets[0], overlapping), findFreeCIDRs(subnets[1], overlapping)...)
+}
+
 // convertToStringSlice converts a slice of *net.IPNet to a slice of strings.
 func convertToStringSlice(ipnets []*net.IPNet) []string {
 	var result []string
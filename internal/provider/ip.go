@@ -0,0 +1,170 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"net"
+	"sort"
+	"strings"
+)
+
+// IPAdd adds an offset to an IP address, the offset can be negative.
+func IPAdd(ipAddress string, offset *big.Int) (string, error) {
+	ip, err := parseIPAddress(ipAddress)
+	if err != nil {
+		return "", err
+	}
+
+	result, err := intToIP(new(big.Int).Add(ipToInt(ip), offset), len(ip))
+	if err != nil {
+		return "", fmt.Errorf("%s plus %s is outside of the IPv%d address range", ipAddress, offset, ipVersion(8*len(ip)))
+	}
+	return result.String(), nil
+}
+
+// IPDiff returns the number of addresses from the second IP address to the first one, negative when the first one is lower.
+func IPDiff(a, b string) (*big.Int, error) {
+	ipA, ipB, err := parseIPAddressPair(a, b)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).Sub(ipToInt(ipA), ipToInt(ipB)), nil
+}
+
+// IPCompare compares two IP addresses numerically, returning -1, 0 or 1.
+func IPCompare(a, b string) (int, error) {
+	ipA, ipB, err := parseIPAddressPair(a, b)
+	if err != nil {
+		return 0, err
+	}
+	return bytes.Compare(ipA, ipB), nil
+}
+
+// IPToInt converts an IP address into its integer value.
+func IPToInt(ipAddress string) (*big.Int, error) {
+	ip, err := parseIPAddress(ipAddress)
+	if err != nil {
+		return nil, err
+	}
+	return ipToInt(ip), nil
+}
+
+// IntToIP converts a decimal integer into an IPv4 or IPv6 address.
+func IntToIP(value string, version int64) (string, error) {
+	var length int
+	switch version {
+	case 4:
+		length = net.IPv4len
+	case 6:
+		length = net.IPv6len
+	default:
+		return "", fmt.Errorf("invalid IP version %d: must be 4 or 6", version)
+	}
+
+	number, ok := new(big.Int).SetString(strings.TrimSpace(value), 10)
+	if !ok {
+		return "", fmt.Errorf("invalid integer %q", value)
+	}
+	ip, err := intToIP(number, length)
+	if err != nil {
+		return "", err
+	}
+	return ip.String(), nil
+}
+
+// IPSort sorts IP addresses and CIDRs numerically, IPv4 before IPv6, then by address and prefix length.
+// The values are returned as given, a bare address sorts like a host network.
+func IPSort(values []string) ([]string, error) {
+	type sortEntry struct {
+		value string
+		ipnet *net.IPNet
+	}
+	entries := make([]sortEntry, 0, len(values))
+	for _, value := range values {
+		ipnet, err := parseIPOrCIDR(value)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, sortEntry{value: value, ipnet: ipnet})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return compareIPNets(entries[i].ipnet, entries[j].ipnet) < 0
+	})
+
+	sorted := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		sorted = append(sorted, entry.value)
+	}
+	return sorted, nil
+}
+
+// Helper functions
+
+// parseIPAddress parses an IP address, IPv4 addresses and IPv4-mapped IPv6 addresses are returned in their 4-byte form.
+func parseIPAddress(ipAddress string) (net.IP, error) {
+	ip := net.ParseIP(strings.TrimSpace(ipAddress))
+	if ip == nil {
+		return nil, fmt.Errorf("invalid IP address: %s", ipAddress)
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4, nil
+	}
+	return ip, nil
+}
+
+// parseIPAddressPair parses two IP addresses of the same address family.
+func parseIPAddressPair(a, b string) (net.IP, net.IP, error) {
+	ipA, err := parseIPAddress(a)
+	if err != nil {
+		return nil, nil, err
+	}
+	ipB, err := parseIPAddress(b)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(ipA) != len(ipB) {
+		return nil, nil, fmt.Errorf("cannot compare IPv%d address %s with IPv%d address %s", ipVersion(8*len(ipA)), a, ipVersion(8*len(ipB)), b)
+	}
+	return ipA, ipB, nil
+}
+
+// parseIPOrCIDR parses a CIDR keeping its host bits, or an IP address as a host network.
+func parseIPOrCIDR(value string) (*net.IPNet, error) {
+	if !strings.Contains(value, "/") {
+		ip, err := parseIPAddress(value)
+		if err != nil {
+			return nil, err
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(8*len(ip), 8*len(ip))}, nil
+	}
+
+	ip, ipnet, err := net.ParseCIDR(strings.TrimSpace(value))
+	if err != nil {
+		return nil, fmt.Errorf("invalid CIDR: %v", err)
+	}
+	if len(ipnet.IP) == net.IPv4len {
+		ip = ip.To4()
+	}
+	return &net.IPNet{IP: ip, Mask: ipnet.Mask}, nil
+}
+
+// ipToInt converts an IP address into its integer value.
+func ipToInt(ip net.IP) *big.Int {
+	return new(big.Int).SetBytes(ip)
+}
+
+// intToIP converts an integer into an IP address of the given length in bytes.
+func intToIP(value *big.Int, length int) (net.IP, error) {
+	if value.Sign() < 0 || value.BitLen() > 8*length {
+		return nil, fmt.Errorf("%s is outside of the IPv%d address range", value, ipVersion(8*length))
+	}
+	ip := make(net.IP, length)
+	value.FillBytes(ip)
+	return ip, nil
+}
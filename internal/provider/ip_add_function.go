@@ -0,0 +1,86 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = IPAddFunction{}
+)
+
+// NewIPAddFunction is a helper function to create a new instance of IPAddFunction.
+func NewIPAddFunction() function.Function {
+	return IPAddFunction{}
+}
+
+// IPAddFunction is the struct for the IP add function.
+type IPAddFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f IPAddFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ip_add"
+}
+
+// Definition sets the definition for the function.
+func (f IPAddFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Add an offset to an IP address",
+		MarkdownDescription: "Adds a positive or negative offset to an IPv4 or IPv6 address, and outputs the resulting address. " +
+			"IPv6 offsets use the full 128-bit range, offsets larger than the precision of a number can be passed as decimal strings. " +
+			"The function fails when the result is outside of the address range of the address family.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "ip_address",
+				MarkdownDescription: "The IPv4 or IPv6 address",
+			},
+			function.NumberParameter{
+				Name:                "offset",
+				MarkdownDescription: "The whole number to add to the address",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run executes the IP add function.
+func (f IPAddFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ipAddress string
+	var offset *big.Float
+
+	// Parse the arguments
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &ipAddress, &offset))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input arguments
+	if ipAddress == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The ip_address argument must be provided and valid"))
+		return
+	}
+	if offset == nil || !offset.IsInt() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The offset argument must be a whole number"))
+		return
+	}
+	offsetInt, _ := offset.Int(nil)
+
+	// Add the offset
+	result, err := IPAdd(ipAddress, offsetInt)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error adding to IP address: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.StringValue(result)))
+}
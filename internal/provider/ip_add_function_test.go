@@ -0,0 +1,106 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestIPAddFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		result    string
+	}{
+		"ipv4": {
+			arguments: `"10.0.0.255", 1`,
+			result:    `"10.0.1.0"`,
+		},
+		"negative-offset": {
+			arguments: `"10.0.1.0", -1`,
+			result:    `"10.0.0.255"`,
+		},
+		"ipv6": {
+			arguments: `"2001:db8::ffff", 1`,
+			result:    `"2001:db8::1:0"`,
+		},
+		"ipv6-large-offset": {
+			arguments: `"::", "18446744073709551616"`,
+			result:    `"0:0:0:1::"`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::ip_add(%s))
+							}
+						`, testCase.arguments),
+						Check: resource.TestCheckOutput("result", testCase.result),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestIPAddFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		error     string
+	}{
+		"empty-ip-address": {
+			arguments: `"", 1`,
+			error:     `(?s)Call to function "provider::iactools::ip_add" failed.*The\s+ip_address\s+argument\s+must\s+be\s+provided\s+and\s+valid`,
+		},
+		"invalid-ip-address": {
+			arguments: `"10.0.0.256", 1`,
+			error:     `(?s)Call to function "provider::iactools::ip_add" failed.*invalid\s+IP\s+address:\s+10.0.0.256`,
+		},
+		"fractional-offset": {
+			arguments: `"10.0.0.1", 1.5`,
+			error:     `(?s)Call to function "provider::iactools::ip_add" failed.*The\s+offset\s+argument\s+must\s+be\s+a\s+whole\s+number`,
+		},
+		"overflow": {
+			arguments: `"255.255.255.255", 1`,
+			error:     `(?s)Call to function "provider::iactools::ip_add" failed.*255.255.255.255\s+plus\s+1\s+is\s+outside\s+of\s+the\s+IPv4\s+address\s+range`,
+		},
+		"underflow": {
+			arguments: `"::", -1`,
+			error:     `(?s)Call to function "provider::iactools::ip_add" failed.*::\s+plus\s+-1\s+is\s+outside\s+of\s+the\s+IPv6\s+address\s+range`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::ip_add(%s)
+							}
+						`, testCase.arguments),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = IPCompareFunction{}
+)
+
+// NewIPCompareFunction is a helper function to create a new instance of IPCompareFunction.
+func NewIPCompareFunction() function.Function {
+	return IPCompareFunction{}
+}
+
+// IPCompareFunction is the struct for the IP compare function.
+type IPCompareFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f IPCompareFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ip_compare"
+}
+
+// Definition sets the definition for the function.
+func (f IPCompareFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Compare two IP addresses numerically",
+		MarkdownDescription: "Outputs -1 when `a` is lower than `b`, 0 when they are the same address and 1 when `a` is higher. Both addresses must be of the same address family.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "a",
+				MarkdownDescription: "The first IPv4 or IPv6 address",
+			},
+			function.StringParameter{
+				Name:                "b",
+				MarkdownDescription: "The second IPv4 or IPv6 address",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+// Run executes the IP compare function.
+func (f IPCompareFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var a, b string
+
+	// Parse the arguments
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &a, &b))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input arguments
+	if a == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The a argument must be provided and valid"))
+		return
+	}
+	if b == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The b argument must be provided and valid"))
+		return
+	}
+
+	// Compare the addresses
+	result, err := IPCompare(a, b)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error comparing IP addresses: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.Int64Value(int64(result))))
+}
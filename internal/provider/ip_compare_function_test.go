@@ -0,0 +1,94 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestIPCompareFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		result    string
+	}{
+		"lower": {
+			arguments: `"10.0.0.9", "10.0.0.10"`,
+			result:    `-1`,
+		},
+		"equal": {
+			arguments: `"10.0.0.1", "::ffff:10.0.0.1"`,
+			result:    `0`,
+		},
+		"higher": {
+			arguments: `"192.168.0.1", "10.255.255.255"`,
+			result:    `1`,
+		},
+		"ipv6": {
+			arguments: `"2001:db8::10", "2001:db8::9"`,
+			result:    `1`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::ip_compare(%s))
+							}
+						`, testCase.arguments),
+						Check: resource.TestCheckOutput("result", testCase.result),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestIPCompareFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		error     string
+	}{
+		"empty-b": {
+			arguments: `"10.0.0.1", ""`,
+			error:     `(?s)Call to function "provider::iactools::ip_compare" failed.*The\s+b\s+argument\s+must\s+be\s+provided\s+and\s+valid`,
+		},
+		"mixed-family": {
+			arguments: `"::1", "10.0.0.1"`,
+			error:     `(?s)Call to function "provider::iactools::ip_compare" failed.*cannot\s+compare\s+IPv6\s+address\s+::1\s+with\s+IPv4\s+address\s+10.0.0.1`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::ip_compare(%s)
+							}
+						`, testCase.arguments),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = IPDiffFunction{}
+)
+
+// NewIPDiffFunction is a helper function to create a new instance of IPDiffFunction.
+func NewIPDiffFunction() function.Function {
+	return IPDiffFunction{}
+}
+
+// IPDiffFunction is the struct for the IP diff function.
+type IPDiffFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f IPDiffFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ip_diff"
+}
+
+// Definition sets the definition for the function.
+func (f IPDiffFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Calculate the distance between two IP addresses",
+		MarkdownDescription: "Outputs `a - b`, the number of addresses from `b` to `a`, as a decimal string, negative when `a` is the lower address. " +
+			"The string keeps the full precision of IPv6 distances, and can be converted with `tonumber` when it fits a number. " +
+			"Both addresses must be of the same address family.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "a",
+				MarkdownDescription: "The IPv4 or IPv6 address to subtract from",
+			},
+			function.StringParameter{
+				Name:                "b",
+				MarkdownDescription: "The IPv4 or IPv6 address to subtract",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run executes the IP diff function.
+func (f IPDiffFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var a, b string
+
+	// Parse the arguments
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &a, &b))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input arguments
+	if a == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The a argument must be provided and valid"))
+		return
+	}
+	if b == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The b argument must be provided and valid"))
+		return
+	}
+
+	// Calculate the difference
+	diff, err := IPDiff(a, b)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error calculating IP address difference: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.StringValue(diff.String())))
+}
@@ -0,0 +1,98 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestIPDiffFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		result    string
+	}{
+		"ipv4": {
+			arguments: `"10.0.1.0", "10.0.0.0"`,
+			result:    `"256"`,
+		},
+		"negative": {
+			arguments: `"10.0.0.0", "10.0.1.0"`,
+			result:    `"-256"`,
+		},
+		"same": {
+			arguments: `"10.0.0.1", "::ffff:10.0.0.1"`,
+			result:    `"0"`,
+		},
+		"ipv6": {
+			arguments: `"2001:db8:0:1::", "2001:db8::"`,
+			result:    `"18446744073709551616"`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::ip_diff(%s))
+							}
+						`, testCase.arguments),
+						Check: resource.TestCheckOutput("result", testCase.result),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestIPDiffFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		error     string
+	}{
+		"empty-a": {
+			arguments: `"", "10.0.0.1"`,
+			error:     `(?s)Call to function "provider::iactools::ip_diff" failed.*The\s+a\s+argument\s+must\s+be\s+provided\s+and\s+valid`,
+		},
+		"invalid-address": {
+			arguments: `"10.0.0.1", "10.0.0"`,
+			error:     `(?s)Call to function "provider::iactools::ip_diff" failed.*invalid\s+IP\s+address:\s+10.0.0`,
+		},
+		"mixed-family": {
+			arguments: `"10.0.0.1", "::1"`,
+			error:     `(?s)Call to function "provider::iactools::ip_diff" failed.*cannot\s+compare\s+IPv4\s+address\s+10.0.0.1\s+with\s+IPv6\s+address\s+::1`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::ip_diff(%s)
+							}
+						`, testCase.arguments),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = IPSortFunction{}
+)
+
+// NewIPSortFunction is a helper function to create a new instance of IPSortFunction.
+func NewIPSortFunction() function.Function {
+	return IPSortFunction{}
+}
+
+// IPSortFunction is the struct for the IP sort function.
+type IPSortFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f IPSortFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ip_sort"
+}
+
+// Definition sets the definition for the function.
+func (f IPSortFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Sort IP addresses and CIDRs numerically",
+		MarkdownDescription: "Sorts a list of IP addresses and CIDRs in numeric instead of lexical order, so `10.0.0.9` comes before `10.0.0.10`. " +
+			"IPv4 values come before IPv6 values, values with the same address are ordered by prefix length, and a bare address sorts like a host network. " +
+			"The values are output as given, duplicates are kept.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "values",
+				ElementType:         types.StringType,
+				MarkdownDescription: "The list of IP addresses and CIDRs",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+// Run executes the IP sort function.
+func (f IPSortFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var values []string
+
+	// Parse the argument
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &values))
+	if resp.Error != nil {
+		return
+	}
+
+	// Sort the values
+	sorted, err := IPSort(values)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error sorting IP addresses: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, sorted))
+}
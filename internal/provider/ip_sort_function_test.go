@@ -0,0 +1,94 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestIPSortFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		result    string
+	}{
+		"mixed": {
+			arguments: `["10.0.0.10", "2001:db8::1", "10.0.0.9", "10.0.0.0/24", "192.168.0.1", "10.0.0.0/8"]`,
+			result:    `["10.0.0.0/8","10.0.0.0/24","10.0.0.9","10.0.0.10","192.168.0.1","2001:db8::1"]`,
+		},
+		"ipv6": {
+			arguments: `["2001:db8::a", "2001:db8::9", "2001:db8::/64"]`,
+			result:    `["2001:db8::/64","2001:db8::9","2001:db8::a"]`,
+		},
+		"duplicates": {
+			arguments: `["10.0.0.2", "10.0.0.1", "10.0.0.2"]`,
+			result:    `["10.0.0.1","10.0.0.2","10.0.0.2"]`,
+		},
+		"empty": {
+			arguments: `[]`,
+			result:    `[]`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::ip_sort(%s))
+							}
+						`, testCase.arguments),
+						Check: resource.TestCheckOutput("result", testCase.result),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestIPSortFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		error     string
+	}{
+		"invalid-address": {
+			arguments: `["10.0.0.1", "10.0.0"]`,
+			error:     `(?s)Call to function "provider::iactools::ip_sort" failed.*invalid\s+IP\s+address:\s+10.0.0`,
+		},
+		"invalid-cidr": {
+			arguments: `["10.0.0.0/33"]`,
+			error:     `(?s)Call to function "provider::iactools::ip_sort" failed.*invalid\s+CIDR`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::ip_sort(%s)
+							}
+						`, testCase.arguments),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
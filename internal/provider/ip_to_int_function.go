@@ -0,0 +1,74 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = IPToIntFunction{}
+)
+
+// NewIPToIntFunction is a helper function to create a new instance of IPToIntFunction.
+func NewIPToIntFunction() function.Function {
+	return IPToIntFunction{}
+}
+
+// IPToIntFunction is the struct for the IP to integer function.
+type IPToIntFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f IPToIntFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ip_to_int"
+}
+
+// Definition sets the definition for the function.
+func (f IPToIntFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Convert an IP address into an integer",
+		MarkdownDescription: "Outputs the integer value of an IPv4 or IPv6 address as a decimal string, keeping the full precision of 128-bit IPv6 addresses. " +
+			"IPv4-mapped IPv6 addresses are converted as IPv4 addresses.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "ip_address",
+				MarkdownDescription: "The IPv4 or IPv6 address",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run executes the IP to integer function.
+func (f IPToIntFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ipAddress string
+
+	// Parse the argument
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &ipAddress))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input argument
+	if ipAddress == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The ip_address argument must be provided and valid"))
+		return
+	}
+
+	// Convert the address
+	result, err := IPToInt(ipAddress)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error converting IP address: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.StringValue(result.String())))
+}
@@ -0,0 +1,94 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestIPToIntFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		result    string
+	}{
+		"ipv4": {
+			arguments: `"10.0.0.1"`,
+			result:    `"167772161"`,
+		},
+		"ipv4-mapped": {
+			arguments: `"::ffff:10.0.0.1"`,
+			result:    `"167772161"`,
+		},
+		"ipv6-loopback": {
+			arguments: `"::1"`,
+			result:    `"1"`,
+		},
+		"ipv6": {
+			arguments: `"2001:db8::"`,
+			result:    `"42540766411282592856903984951653826560"`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::ip_to_int(%s))
+							}
+						`, testCase.arguments),
+						Check: resource.TestCheckOutput("result", testCase.result),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestIPToIntFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		error     string
+	}{
+		"empty-ip-address": {
+			arguments: `""`,
+			error:     `(?s)Call to function "provider::iactools::ip_to_int" failed.*The\s+ip_address\s+argument\s+must\s+be\s+provided\s+and\s+valid`,
+		},
+		"cidr": {
+			arguments: `"10.0.0.0/24"`,
+			error:     `(?s)Call to function "provider::iactools::ip_to_int" failed.*invalid\s+IP\s+address:\s+10.0.0.0/24`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::ip_to_int(%s)
+							}
+						`, testCase.arguments),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = IPv6EUI64Function{}
+)
+
+// NewIPv6EUI64Function is a helper function to create a new instance of IPv6EUI64Function.
+func NewIPv6EUI64Function() function.Function {
+	return IPv6EUI64Function{}
+}
+
+// IPv6EUI64Function is the struct for the IPv6 EUI-64 function.
+type IPv6EUI64Function struct{}
+
+// Metadata sets the metadata for the function.
+func (f IPv6EUI64Function) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ipv6_eui64"
+}
+
+// Definition sets the definition for the function.
+func (f IPv6EUI64Function) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Calculate the SLAAC address of a MAC address",
+		MarkdownDescription: "Outputs the IPv6 address a host with the MAC address configures with SLAAC in the prefix. " +
+			"The last 64 bits of the address are the modified EUI-64 interface identifier: the MAC address with `ff:fe` inserted in the middle and the universal/local bit flipped.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "prefix",
+				MarkdownDescription: "The IPv6 /64 prefix, e.g. `2001:db8:1:2::/64`, other prefix lengths are rejected",
+			},
+			function.StringParameter{
+				Name:                "mac_address",
+				MarkdownDescription: "The MAC address in colon, dash, Cisco dot or bare form",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run executes the IPv6 EUI-64 function.
+func (f IPv6EUI64Function) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var prefix, macAddress string
+
+	// Parse the arguments
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &prefix, &macAddress))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input arguments
+	if prefix == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The prefix argument must be provided and valid"))
+		return
+	}
+	if macAddress == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The mac_address argument must be provided and valid"))
+		return
+	}
+
+	// Calculate the address
+	result, err := IPv6EUI64(prefix, macAddress)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error calculating EUI-64 address: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.StringValue(result)))
+}
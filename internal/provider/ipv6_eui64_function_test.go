@@ -0,0 +1,110 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestIPv6EUI64Function_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		result    string
+	}{
+		"colon": {
+			arguments: `"2001:db8:1:2::/64", "00:0d:3a:12:34:56"`,
+			result:    `"2001:db8:1:2:20d:3aff:fe12:3456"`,
+		},
+		"dash-upper-case": {
+			arguments: `"2001:db8:1:2::/64", "00-0D-3A-12-34-56"`,
+			result:    `"2001:db8:1:2:20d:3aff:fe12:3456"`,
+		},
+		"cisco-dot": {
+			arguments: `"2001:db8:1:2::/64", "000d.3a12.3456"`,
+			result:    `"2001:db8:1:2:20d:3aff:fe12:3456"`,
+		},
+		"local-bit-cleared": {
+			arguments: `"2001:db8::/64", "02:00:5e:10:00:01"`,
+			result:    `"2001:db8::5eff:fe10:1"`,
+		},
+		"host-bits-ignored": {
+			arguments: `"2001:db8:1:2::1/64", "000d3a123456"`,
+			result:    `"2001:db8:1:2:20d:3aff:fe12:3456"`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::ipv6_eui64(%s))
+							}
+						`, testCase.arguments),
+						Check: resource.TestCheckOutput("result", testCase.result),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestIPv6EUI64Function_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		error     string
+	}{
+		"empty-prefix": {
+			arguments: `"", "00:0d:3a:12:34:56"`,
+			error:     `(?s)Call to function "provider::iactools::ipv6_eui64" failed.*The\s+prefix\s+argument\s+must\s+be\s+provided\s+and\s+valid`,
+		},
+		"ipv4-prefix": {
+			arguments: `"10.0.0.0/8", "00:0d:3a:12:34:56"`,
+			error:     `(?s)Call to function "provider::iactools::ipv6_eui64" failed.*prefix\s+10.0.0.0/8\s+is\s+not\s+an\s+IPv6\s+prefix`,
+		},
+		"long-prefix": {
+			arguments: `"2001:db8::/96", "00:0d:3a:12:34:56"`,
+			error:     `(?s)Call to function "provider::iactools::ipv6_eui64" failed.*prefix\s+2001:db8::/96\s+is\s+not\s+a\s+/64`,
+		},
+		"short-prefix": {
+			arguments: `"2001:db8::/48", "00:0d:3a:12:34:56"`,
+			error:     `(?s)Call to function "provider::iactools::ipv6_eui64" failed.*prefix\s+2001:db8::/48\s+is\s+not\s+a\s+/64`,
+		},
+		"invalid-mac": {
+			arguments: `"2001:db8::/64", "00:0d:3a:12:34"`,
+			error:     `(?s)Call to function "provider::iactools::ipv6_eui64" failed.*invalid\s+MAC\s+address:\s+00:0d:3a:12:34`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::ipv6_eui64(%s)
+							}
+						`, testCase.arguments),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = IPv6EUI64ToMACFunction{}
+)
+
+// NewIPv6EUI64ToMACFunction is a helper function to create a new instance of IPv6EUI64ToMACFunction.
+func NewIPv6EUI64ToMACFunction() function.Function {
+	return IPv6EUI64ToMACFunction{}
+}
+
+// IPv6EUI64ToMACFunction is the struct for the IPv6 EUI-64 to MAC function.
+type IPv6EUI64ToMACFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f IPv6EUI64ToMACFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ipv6_eui64_to_mac"
+}
+
+// Definition sets the definition for the function.
+func (f IPv6EUI64ToMACFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Recover the MAC address of an EUI-64 IPv6 address",
+		MarkdownDescription: "Outputs the MAC address in colon form that the modified EUI-64 interface identifier of a SLAAC or link-local IPv6 address was derived from, the reverse of `ipv6_eui64`. " +
+			"The function fails when the interface identifier doesn't contain `ff:fe` in the middle, like privacy or manually assigned addresses.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "ip_address",
+				MarkdownDescription: "The IPv6 address",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run executes the IPv6 EUI-64 to MAC function.
+func (f IPv6EUI64ToMACFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ipAddress string
+
+	// Parse the argument
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &ipAddress))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input argument
+	if ipAddress == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The ip_address argument must be provided and valid"))
+		return
+	}
+
+	// Recover the MAC address
+	result, err := IPv6EUI64ToMAC(ipAddress)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error recovering MAC address: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.StringValue(result)))
+}
@@ -0,0 +1,90 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestIPv6EUI64ToMACFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		result    string
+	}{
+		"slaac": {
+			arguments: `"2001:db8:1:2:20d:3aff:fe12:3456"`,
+			result:    `"00:0d:3a:12:34:56"`,
+		},
+		"link-local": {
+			arguments: `"fe80::5eff:fe10:1"`,
+			result:    `"02:00:5e:10:00:01"`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::ipv6_eui64_to_mac(%s))
+							}
+						`, testCase.arguments),
+						Check: resource.TestCheckOutput("result", testCase.result),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestIPv6EUI64ToMACFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		error     string
+	}{
+		"empty-ip-address": {
+			arguments: `""`,
+			error:     `(?s)Call to function "provider::iactools::ipv6_eui64_to_mac" failed.*The\s+ip_address\s+argument\s+must\s+be\s+provided\s+and\s+valid`,
+		},
+		"ipv4": {
+			arguments: `"10.0.0.1"`,
+			error:     `(?s)Call to function "provider::iactools::ipv6_eui64_to_mac" failed.*invalid\s+IPv6\s+address:\s+10.0.0.1`,
+		},
+		"not-eui64": {
+			arguments: `"2001:db8::1"`,
+			error:     `(?s)Call to function "provider::iactools::ipv6_eui64_to_mac" failed.*2001:db8::1\s+is\s+not\s+an\s+EUI-64\s+address`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::ipv6_eui64_to_mac(%s)
+							}
+						`, testCase.arguments),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
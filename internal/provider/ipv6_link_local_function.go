@@ -0,0 +1,73 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = IPv6LinkLocalFunction{}
+)
+
+// NewIPv6LinkLocalFunction is a helper function to create a new instance of IPv6LinkLocalFunction.
+func NewIPv6LinkLocalFunction() function.Function {
+	return IPv6LinkLocalFunction{}
+}
+
+// IPv6LinkLocalFunction is the struct for the IPv6 link-local function.
+type IPv6LinkLocalFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f IPv6LinkLocalFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ipv6_link_local"
+}
+
+// Definition sets the definition for the function.
+func (f IPv6LinkLocalFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Calculate the IPv6 link-local address of a MAC address",
+		MarkdownDescription: "Outputs the `fe80::/64` link-local address of a MAC address, using its modified EUI-64 interface identifier like `ipv6_eui64`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "mac_address",
+				MarkdownDescription: "The MAC address in colon, dash, Cisco dot or bare form",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run executes the IPv6 link-local function.
+func (f IPv6LinkLocalFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var macAddress string
+
+	// Parse the argument
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &macAddress))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input argument
+	if macAddress == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The mac_address argument must be provided and valid"))
+		return
+	}
+
+	// Calculate the address
+	result, err := IPv6LinkLocal(macAddress)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error calculating link-local address: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.StringValue(result)))
+}
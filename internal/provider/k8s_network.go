@@ -0,0 +1,355 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"math/big"
+	"math/bits"
+	"net"
+	"strings"
+)
+
+// Kubernetes network plugins.
+const (
+	k8sNetworkPluginKubenet = "kubenet"
+	k8sNetworkPluginOverlay = "overlay"
+	k8sNetworkPluginVNet    = "vnet"
+)
+
+// Kubernetes network defaults.
+const (
+	k8sDefaultCloud       = "azure"
+	k8sDefaultMaxPods     = 110
+	k8sDefaultSurgeNodes  = 1
+	k8sDefaultPodCIDR     = "10.244.0.0/16"
+	k8sDefaultServiceCIDR = "10.0.0.0/16"
+)
+
+// Bounds of the node count and the max pods per node.
+// The node count including the surge nodes is limited to the largest cluster of the managed services, every node is listed in node_pod_cidrs.
+const (
+	k8sMaxNodeCount   = 15000
+	k8sMaxPodsPerNode = 65536
+)
+
+// k8sServiceCIDRMaxHostBits is the largest service CIDR kube-apiserver accepts, a /12 for IPv4 and a /108 for IPv6.
+const k8sServiceCIDRMaxHostBits = 20
+
+// k8sNodeCIDRMaxDiff is the largest difference between the pod CIDR and the per-node pod range prefix lengths the node IPAM controller accepts.
+const k8sNodeCIDRMaxDiff = 16
+
+// k8sDNSServiceIPOffset is the offset of the conventional DNS service IP in the service CIDR, like 10.0.0.10.
+const k8sDNSServiceIPOffset = 10
+
+// k8sCloudLimit holds the network limits of a managed Kubernetes service.
+type k8sCloudLimit struct {
+	reservedIPs      int64
+	maxPods          int64
+	maxNodes         int64
+	maxKubenetNodes  int64
+	nodeCIDRMaskSize int
+}
+
+// k8sCloudLimits holds the limits of AKS, EKS and GKE, zero means the limit is not checked.
+// AKS gives every node a /24 pod range and is limited to 400 kubenet nodes by the route table size,
+// GKE sizes the pod ranges for twice the max pods.
+var k8sCloudLimits = map[string]k8sCloudLimit{
+	"azure": {reservedIPs: 5, maxPods: 250, maxNodes: 5000, maxKubenetNodes: 400, nodeCIDRMaskSize: 24},
+	"aws":   {reservedIPs: 5},
+	"gcp":   {reservedIPs: 4, maxPods: 256, maxNodes: 15000},
+}
+
+// K8sNetworkInputs holds the cluster size and the networks of a Kubernetes network plan.
+type K8sNetworkInputs struct {
+	Cloud            string
+	NetworkPlugin    string
+	NodeCount        int64
+	MaxPods          int64
+	SurgeNodes       *int64
+	NodeCIDRMaskSize int64
+	ParentCIDR       string
+	ReservedCIDRs    []string
+	NodeSubnetCIDR   string
+	PodCIDR          string
+	ServiceCIDR      string
+}
+
+// K8sNetwork holds the required prefix lengths and the CIDRs of a Kubernetes cluster.
+type K8sNetwork struct {
+	NetworkPlugin          string   `tfsdk:"network_plugin"`
+	TotalNodes             int64    `tfsdk:"total_nodes"`
+	MaxPods                int64    `tfsdk:"max_pods"`
+	PodCapacity            int64    `tfsdk:"pod_capacity"`
+	NodeSubnetIPs          int64    `tfsdk:"node_subnet_ips"`
+	NodeSubnetPrefixLength int64    `tfsdk:"node_subnet_prefix_length"`
+	NodeSubnetCIDR         *string  `tfsdk:"node_subnet_cidr"`
+	PodCIDRPrefixLength    *int64   `tfsdk:"pod_cidr_prefix_length"`
+	PodCIDR                *string  `tfsdk:"pod_cidr"`
+	NodePodPrefixLength    *int64   `tfsdk:"node_pod_prefix_length"`
+	NodePodCIDRs           []string `tfsdk:"node_pod_cidrs"`
+	ServiceCIDR            string   `tfsdk:"service_cidr"`
+	DNSServiceIP           string   `tfsdk:"dns_service_ip"`
+}
+
+// K8sNetworkPlan sizes the node subnet and the pod CIDR of a Kubernetes cluster, allocates the node subnet from the parent network
+// when it isn't set, and checks that the networks fit the nodes and don't overlap each other.
+// Nodes get their pod addresses from the node subnet with the vnet plugin, and a pod range from the pod CIDR with the kubenet and overlay plugins.
+// All exceeded limits are reported in a single error.
+func K8sNetworkPlan(inputs K8sNetworkInputs) (K8sNetwork, error) {
+	var plan K8sNetwork
+
+	cloud := strings.ToLower(inputs.Cloud)
+	if cloud == "" {
+		cloud = k8sDefaultCloud
+	}
+	limit, ok := k8sCloudLimits[cloud]
+	if !ok {
+		return plan, fmt.Errorf("unknown cloud %q, must be one of azure, aws or gcp", inputs.Cloud)
+	}
+	plugin := strings.ToLower(inputs.NetworkPlugin)
+	switch plugin {
+	case "":
+		plugin = k8sNetworkPluginOverlay
+	case k8sNetworkPluginKubenet, k8sNetworkPluginOverlay, k8sNetworkPluginVNet:
+	default:
+		return plan, fmt.Errorf("unknown network plugin %q, must be one of %s, %s or %s", inputs.NetworkPlugin, k8sNetworkPluginKubenet, k8sNetworkPluginOverlay, k8sNetworkPluginVNet)
+	}
+
+	surgeNodes := int64(k8sDefaultSurgeNodes)
+	if inputs.SurgeNodes != nil {
+		surgeNodes = *inputs.SurgeNodes
+	}
+	maxPods := inputs.MaxPods
+	if maxPods == 0 {
+		maxPods = k8sDefaultMaxPods
+	}
+	if inputs.NodeCount < 1 || inputs.NodeCount > k8sMaxNodeCount {
+		return plan, fmt.Errorf("node_count must be between 1 and %d", k8sMaxNodeCount)
+	}
+	if maxPods < 1 || maxPods > k8sMaxPodsPerNode {
+		return plan, fmt.Errorf("max_pods must be between 1 and %d", k8sMaxPodsPerNode)
+	}
+	if surgeNodes < 0 || surgeNodes > k8sMaxNodeCount {
+		return plan, fmt.Errorf("surge_nodes must be between 0 and %d", k8sMaxNodeCount)
+	}
+	if inputs.NodeCount+surgeNodes > k8sMaxNodeCount {
+		return plan, fmt.Errorf("node_count and surge_nodes must not exceed %d nodes together", k8sMaxNodeCount)
+	}
+	if inputs.NodeCIDRMaskSize < 0 {
+		return plan, fmt.Errorf("node_cidr_mask_size must not be negative")
+	}
+
+	parent, err := parseOptionalCIDR(inputs.ParentCIDR, "parent_cidr")
+	if err != nil {
+		return plan, err
+	}
+	reserved, err := parseCIDRList(inputs.ReservedCIDRs)
+	if err != nil {
+		return plan, fmt.Errorf("invalid reserved_cidrs: %v", err)
+	}
+	nodeSubnet, err := parseOptionalCIDR(inputs.NodeSubnetCIDR, "node_subnet_cidr")
+	if err != nil {
+		return plan, err
+	}
+	podCIDR, err := parseOptionalCIDR(defaultString(inputs.PodCIDR, k8sDefaultPodCIDR), "pod_cidr")
+	if err != nil {
+		return plan, err
+	}
+	serviceCIDR, err := parseOptionalCIDR(defaultString(inputs.ServiceCIDR, k8sDefaultServiceCIDR), "service_cidr")
+	if err != nil {
+		return plan, err
+	}
+
+	var problems []string
+	totalNodes := inputs.NodeCount + surgeNodes
+	plan.NetworkPlugin = plugin
+	plan.TotalNodes = totalNodes
+	plan.MaxPods = maxPods
+	plan.PodCapacity = inputs.NodeCount * maxPods
+	plan.NodePodCIDRs = make([]string, 0)
+
+	if limit.maxPods > 0 && maxPods > limit.maxPods {
+		problems = append(problems, fmt.Sprintf("max_pods %d exceeds the limit of %d pods per node on %s", maxPods, limit.maxPods, cloud))
+	}
+	if limit.maxNodes > 0 && totalNodes > limit.maxNodes {
+		problems = append(problems, fmt.Sprintf("%d nodes including %d surge nodes exceed the limit of %d nodes per cluster on %s", totalNodes, surgeNodes, limit.maxNodes, cloud))
+	}
+	if plugin == k8sNetworkPluginKubenet && limit.maxKubenetNodes > 0 && totalNodes > limit.maxKubenetNodes {
+		problems = append(problems, fmt.Sprintf("%d nodes including %d surge nodes exceed the limit of %d kubenet nodes on %s, as every node needs a route in the route table", totalNodes, surgeNodes, limit.maxKubenetNodes, cloud))
+	}
+
+	// Size the node subnet, with the vnet plugin the pods get their addresses from the node subnet as well
+	addressBits := 32
+	switch {
+	case nodeSubnet != nil:
+		_, addressBits = nodeSubnet.Mask.Size()
+	case parent != nil:
+		_, addressBits = parent.Mask.Size()
+	}
+	ipsPerNode := int64(1)
+	if plugin == k8sNetworkPluginVNet {
+		ipsPerNode += maxPods
+	}
+	plan.NodeSubnetIPs = totalNodes*ipsPerNode + limit.reservedIPs
+	nodeSubnetPrefixLength := addressBits - ceilLog2(plan.NodeSubnetIPs)
+	plan.NodeSubnetPrefixLength = int64(nodeSubnetPrefixLength)
+	if nodeSubnetPrefixLength < 0 {
+		problems = append(problems, fmt.Sprintf("the node subnet needs %d addresses for %d nodes, more than an IPv%d network has", plan.NodeSubnetIPs, totalNodes, ipVersion(addressBits)))
+	}
+
+	switch {
+	case nodeSubnet != nil:
+		if ones, _ := nodeSubnet.Mask.Size(); nodeSubnetPrefixLength >= 0 && ones > nodeSubnetPrefixLength {
+			problems = append(problems, fmt.Sprintf("node subnet %s has %s usable addresses, %d nodes including %d surge nodes need %d, a /%d",
+				nodeSubnet, usableAddresses(nodeSubnet, limit.reservedIPs), totalNodes, surgeNodes, plan.NodeSubnetIPs-limit.reservedIPs, nodeSubnetPrefixLength))
+		}
+		if parent != nil && !ipNetContains(parent, nodeSubnet) {
+			problems = append(problems, fmt.Sprintf("node subnet %s is not inside parent %s", nodeSubnet, parent))
+		}
+		for _, ipnet := range reserved {
+			if ipNetsOverlap(nodeSubnet, ipnet) {
+				problems = append(problems, fmt.Sprintf("node subnet %s overlaps reserved CIDR %s", nodeSubnet, ipnet))
+			}
+		}
+	case parent != nil && nodeSubnetPrefixLength >= 0:
+		if nodeSubnet = allocateCIDR(parent, nodeSubnetPrefixLength, reserved); nodeSubnet == nil {
+			problems = append(problems, fmt.Sprintf("parent %s has no free /%d for the node subnet of %d nodes including %d surge nodes", parent, nodeSubnetPrefixLength, totalNodes, surgeNodes))
+		}
+	}
+	if nodeSubnet != nil {
+		plan.NodeSubnetCIDR = stringPointer(nodeSubnet.String())
+	}
+
+	// Size the pod CIDR and split it into the per-node pod ranges
+	if plugin != k8sNetworkPluginVNet {
+		_, podBits := podCIDR.Mask.Size()
+		nodePodPrefixLength := int(inputs.NodeCIDRMaskSize)
+		switch {
+		case nodePodPrefixLength > 0:
+		case limit.nodeCIDRMaskSize > 0 && podBits == 32:
+			nodePodPrefixLength = limit.nodeCIDRMaskSize
+		default:
+			nodePodPrefixLength = podBits - ceilLog2(2*maxPods)
+		}
+		podCIDRPrefixLength := nodePodPrefixLength - ceilLog2(totalNodes)
+		plan.NodePodPrefixLength = int64Pointer(int64(nodePodPrefixLength))
+		plan.PodCIDRPrefixLength = int64Pointer(int64(podCIDRPrefixLength))
+		plan.PodCIDR = stringPointer(podCIDR.String())
+
+		podOnes, _ := podCIDR.Mask.Size()
+		switch {
+		case nodePodPrefixLength > podBits || nodePodPrefixLength < podOnes:
+			problems = append(problems, fmt.Sprintf("per-node pod range /%d must be between the pod CIDR prefix length /%d and /%d", nodePodPrefixLength, podOnes, podBits))
+		case addressCount(podBits-nodePodPrefixLength).Cmp(big.NewInt(maxPods)) < 0:
+			problems = append(problems, fmt.Sprintf("per-node pod range /%d has %s addresses, less than max_pods %d", nodePodPrefixLength, addressCount(podBits-nodePodPrefixLength), maxPods))
+		case nodePodPrefixLength-podOnes > k8sNodeCIDRMaxDiff:
+			problems = append(problems, fmt.Sprintf("per-node pod range /%d is more than %d bits longer than pod CIDR %s", nodePodPrefixLength, k8sNodeCIDRMaxDiff, podCIDR))
+		case podOnes > podCIDRPrefixLength:
+			problems = append(problems, fmt.Sprintf("pod CIDR %s has %s per-node /%d pod ranges, %d nodes including %d surge nodes need a /%d",
+				podCIDR, addressCount(nodePodPrefixLength-podOnes), nodePodPrefixLength, totalNodes, surgeNodes, podCIDRPrefixLength))
+		default:
+			for i := int64(0); i < totalNodes; i++ {
+				plan.NodePodCIDRs = append(plan.NodePodCIDRs, subnetAt(podCIDR, nodePodPrefixLength, i).String())
+			}
+		}
+
+		if parent != nil && ipNetsOverlap(podCIDR, parent) {
+			problems = append(problems, fmt.Sprintf("pod CIDR %s overlaps parent %s", podCIDR, parent))
+		}
+		if nodeSubnet != nil && ipNetsOverlap(podCIDR, nodeSubnet) {
+			problems = append(problems, fmt.Sprintf("pod CIDR %s overlaps node subnet %s", podCIDR, nodeSubnet))
+		}
+		if ipNetsOverlap(podCIDR, serviceCIDR) {
+			problems = append(problems, fmt.Sprintf("pod CIDR %s overlaps service CIDR %s", podCIDR, serviceCIDR))
+		}
+	}
+
+	// Check the service CIDR and pick the DNS service IP
+	serviceOnes, serviceBits := serviceCIDR.Mask.Size()
+	plan.ServiceCIDR = serviceCIDR.String()
+	if serviceBits-serviceOnes > k8sServiceCIDRMaxHostBits {
+		problems = append(problems, fmt.Sprintf("service CIDR %s is larger than the /%d kube-apiserver accepts", serviceCIDR, serviceBits-k8sServiceCIDRMaxHostBits))
+	}
+	if addressCount(serviceBits-serviceOnes).Cmp(big.NewInt(k8sDNSServiceIPOffset+2)) < 0 {
+		problems = append(problems, fmt.Sprintf("service CIDR %s is too small for the DNS service IP", serviceCIDR))
+	} else {
+		plan.DNSServiceIP = subnetAt(serviceCIDR, serviceBits, k8sDNSServiceIPOffset).IP.String()
+	}
+	if parent != nil && ipNetsOverlap(serviceCIDR, parent) {
+		problems = append(problems, fmt.Sprintf("service CIDR %s overlaps parent %s", serviceCIDR, parent))
+	}
+	if nodeSubnet != nil && ipNetsOverlap(serviceCIDR, nodeSubnet) {
+		problems = append(problems, fmt.Sprintf("service CIDR %s overlaps node subnet %s", serviceCIDR, nodeSubnet))
+	}
+
+	if len(problems) > 0 {
+		return plan, fmt.Errorf("%s", strings.Join(problems, "; "))
+	}
+	return plan, nil
+}
+
+// Helper functions
+
+// parseOptionalCIDR parses a CIDR in its canonical network form, an empty CIDR is nil.
+func parseOptionalCIDR(cidr, name string) (*net.IPNet, error) {
+	if cidr == "" {
+		return nil, nil
+	}
+	_, ipnet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %v", name, err)
+	}
+	return ipnet, nil
+}
+
+// ceilLog2 returns the number of bits needed for n addresses.
+func ceilLog2(n int64) int {
+	if n <= 1 {
+		return 0
+	}
+	return bits.Len64(uint64(n - 1))
+}
+
+// addressCount returns the number of addresses of a network with the number of host bits.
+func addressCount(hostBits int) *big.Int {
+	return new(big.Int).Lsh(big.NewInt(1), uint(max(hostBits, 0)))
+}
+
+// usableAddresses returns the number of addresses of a network without the addresses reserved by the cloud.
+func usableAddresses(ipnet *net.IPNet, reservedIPs int64) *big.Int {
+	ones, addressBits := ipnet.Mask.Size()
+	usable := new(big.Int).Sub(addressCount(addressBits-ones), big.NewInt(reservedIPs))
+	if usable.Sign() < 0 {
+		usable.SetInt64(0)
+	}
+	return usable
+}
+
+// ipVersion returns the IP version of an address length in bits.
+func ipVersion(addressBits int) int {
+	if addressBits == 128 {
+		return 6
+	}
+	return 4
+}
+
+// defaultString returns the value, or the fallback when the value is empty.
+func defaultString(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
+
+// stringPointer returns a pointer to the string.
+func stringPointer(value string) *string {
+	return &value
+}
+
+// int64Pointer returns a pointer to the integer.
+func int64Pointer(value int64) *int64 {
+	return &value
+}
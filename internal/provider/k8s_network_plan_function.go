@@ -0,0 +1,168 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = K8sNetworkPlanFunction{}
+)
+
+// NewK8sNetworkPlanFunction is a helper function to create a new instance of K8sNetworkPlanFunction.
+func NewK8sNetworkPlanFunction() function.Function {
+	return K8sNetworkPlanFunction{}
+}
+
+// K8sNetworkPlanFunction is the struct for the Kubernetes network plan function.
+type K8sNetworkPlanFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f K8sNetworkPlanFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "k8s_network_plan"
+}
+
+// Definition sets the definition for the function.
+func (f K8sNetworkPlanFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Size and validate the node subnet, pod CIDR and service CIDR of a Kubernetes cluster",
+		MarkdownDescription: "Calculates the prefix lengths a Kubernetes cluster of `node_count` nodes with `max_pods` pods per node needs, " +
+			"and checks the networks against them. Surge nodes added during upgrades are counted as nodes. " +
+			"With the `vnet` network plugin (Azure CNI, AWS VPC CNI) every pod gets an address of the node subnet, " +
+			"with the `kubenet` and `overlay` plugins every node gets a pod range of the pod CIDR, listed in `node_pod_cidrs`. " +
+			"The per-node pod ranges are /24 networks on Azure, and sized for twice the max pods on the other clouds. " +
+			"When `node_subnet_cidr` isn't set, the node subnet is allocated as the lowest free network of the required size in `parent_cidr`, outside of `reserved_cidrs`. " +
+			"The DNS service IP is the 10th address of the service CIDR. " +
+			"The function fails with all exceeded limits when the networks are too small, overlap each other or exceed the limits of the cloud: " +
+			"250 pods per node and 5000 nodes on Azure, of which 400 with kubenet, and 256 pods per node and 15000 nodes on GCP. " +
+			"At most 15000 nodes including the surge nodes are supported on every cloud.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name: "inputs",
+				MarkdownDescription: "An object with the required attribute `node_count` and the optional attributes " +
+					"`cloud` (`azure`, `aws` or `gcp`, defaults to `azure`), `network_plugin` (`kubenet`, `overlay` or `vnet`, defaults to `overlay`), " +
+					"`max_pods` (defaults to 110), `surge_nodes` (defaults to 1), `node_cidr_mask_size` (the prefix length of the per-node pod ranges), " +
+					"`parent_cidr` (the VNet or VPC), `reserved_cidrs` (the subnets of the parent in use), `node_subnet_cidr`, " +
+					"`pod_cidr` (defaults to `10.244.0.0/16`) and `service_cidr` (defaults to `10.0.0.0/16`)",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"network_plugin":            types.StringType,
+				"total_nodes":               types.Int64Type,
+				"max_pods":                  types.Int64Type,
+				"pod_capacity":              types.Int64Type,
+				"node_subnet_ips":           types.Int64Type,
+				"node_subnet_prefix_length": types.Int64Type,
+				"node_subnet_cidr":          types.StringType,
+				"pod_cidr_prefix_length":    types.Int64Type,
+				"pod_cidr":                  types.StringType,
+				"node_pod_prefix_length":    types.Int64Type,
+				"node_pod_cidrs":            types.ListType{ElemType: types.StringType},
+				"service_cidr":              types.StringType,
+				"dns_service_ip":            types.StringType,
+			},
+		},
+	}
+}
+
+// Run executes the Kubernetes network plan function.
+func (f K8sNetworkPlanFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var inputsArgument types.Dynamic
+
+	// Parse the argument
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &inputsArgument))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input argument
+	inputs, err := parseK8sNetworkInputs(inputsArgument)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error parsing inputs: %s", err.Error())))
+		return
+	}
+
+	// Plan the network
+	plan, err := K8sNetworkPlan(inputs)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error planning Kubernetes network: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, plan))
+}
+
+// parseK8sNetworkInputs converts the inputs argument into Kubernetes network inputs.
+func parseK8sNetworkInputs(value types.Dynamic) (K8sNetworkInputs, error) {
+	var inputs K8sNetworkInputs
+	converted, err := dynamicToGo(value)
+	if err != nil {
+		return inputs, err
+	}
+	object, err := goObject(converted, "inputs")
+	if err != nil {
+		return inputs, err
+	}
+	if err := checkObjectKeys(object, "inputs", "cloud", "network_plugin", "node_count", "max_pods", "surge_nodes", "node_cidr_mask_size",
+		"parent_cidr", "reserved_cidrs", "node_subnet_cidr", "pod_cidr", "service_cidr"); err != nil {
+		return inputs, err
+	}
+
+	if inputs.NodeCount, err = goInt64(object["node_count"], "inputs.node_count"); err != nil {
+		return inputs, err
+	}
+	for _, field := range []struct {
+		name  string
+		value *string
+	}{
+		{"cloud", &inputs.Cloud},
+		{"network_plugin", &inputs.NetworkPlugin},
+		{"parent_cidr", &inputs.ParentCIDR},
+		{"node_subnet_cidr", &inputs.NodeSubnetCIDR},
+		{"pod_cidr", &inputs.PodCIDR},
+		{"service_cidr", &inputs.ServiceCIDR},
+	} {
+		if object[field.name] != nil {
+			if *field.value, err = goString(object[field.name], "inputs."+field.name); err != nil {
+				return inputs, err
+			}
+		}
+	}
+	for _, field := range []struct {
+		name  string
+		value *int64
+	}{
+		{"max_pods", &inputs.MaxPods},
+		{"node_cidr_mask_size", &inputs.NodeCIDRMaskSize},
+	} {
+		if object[field.name] != nil {
+			if *field.value, err = goInt64(object[field.name], "inputs."+field.name); err != nil {
+				return inputs, err
+			}
+		}
+	}
+	if object["surge_nodes"] != nil {
+		surgeNodes, err := goInt64(object["surge_nodes"], "inputs.surge_nodes")
+		if err != nil {
+			return inputs, err
+		}
+		inputs.SurgeNodes = &surgeNodes
+	}
+	if object["reserved_cidrs"] != nil {
+		if inputs.ReservedCIDRs, err = goStringList(object["reserved_cidrs"], "inputs.reserved_cidrs"); err != nil {
+			return inputs, err
+		}
+	}
+	return inputs, nil
+}
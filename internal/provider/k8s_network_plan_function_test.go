@@ -0,0 +1,150 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestK8sNetworkPlanFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		inputs string
+		plan   string
+	}{
+		"overlay-defaults": {
+			inputs: `{ node_count = 3, parent_cidr = "10.1.0.0/16" }`,
+			plan: `{"dns_service_ip":"10.0.0.10","max_pods":110,"network_plugin":"overlay",` +
+				`"node_pod_cidrs":["10.244.0.0/24","10.244.1.0/24","10.244.2.0/24","10.244.3.0/24"],"node_pod_prefix_length":24,` +
+				`"node_subnet_cidr":"10.1.0.0/28","node_subnet_ips":9,"node_subnet_prefix_length":28,` +
+				`"pod_capacity":330,"pod_cidr":"10.244.0.0/16","pod_cidr_prefix_length":22,"service_cidr":"10.0.0.0/16","total_nodes":4}`,
+		},
+		"vnet-allocated-around-reserved": {
+			inputs: `{ network_plugin = "vnet", node_count = 9, max_pods = 30, parent_cidr = "10.1.0.0/16", reserved_cidrs = ["10.1.0.0/24"], service_cidr = "172.16.0.0/16" }`,
+			plan: `{"dns_service_ip":"172.16.0.10","max_pods":30,"network_plugin":"vnet",` +
+				`"node_pod_cidrs":[],"node_pod_prefix_length":null,` +
+				`"node_subnet_cidr":"10.1.2.0/23","node_subnet_ips":315,"node_subnet_prefix_length":23,` +
+				`"pod_capacity":270,"pod_cidr":null,"pod_cidr_prefix_length":null,"service_cidr":"172.16.0.0/16","total_nodes":10}`,
+		},
+		"gcp-kubenet-explicit-subnet": {
+			inputs: `{ cloud = "gcp", network_plugin = "kubenet", node_count = 2, max_pods = 64, surge_nodes = 0, node_subnet_cidr = "10.10.0.0/24", pod_cidr = "10.20.0.0/20", service_cidr = "10.30.0.0/20" }`,
+			plan: `{"dns_service_ip":"10.30.0.10","max_pods":64,"network_plugin":"kubenet",` +
+				`"node_pod_cidrs":["10.20.0.0/25","10.20.0.128/25"],"node_pod_prefix_length":25,` +
+				`"node_subnet_cidr":"10.10.0.0/24","node_subnet_ips":6,"node_subnet_prefix_length":29,` +
+				`"pod_capacity":128,"pod_cidr":"10.20.0.0/20","pod_cidr_prefix_length":24,"service_cidr":"10.30.0.0/20","total_nodes":2}`,
+		},
+		"without-parent": {
+			inputs: `{ cloud = "aws", node_count = 1, max_pods = 50, node_cidr_mask_size = 26, surge_nodes = 0 }`,
+			plan: `{"dns_service_ip":"10.0.0.10","max_pods":50,"network_plugin":"overlay",` +
+				`"node_pod_cidrs":["10.244.0.0/26"],"node_pod_prefix_length":26,` +
+				`"node_subnet_cidr":null,"node_subnet_ips":6,"node_subnet_prefix_length":29,` +
+				`"pod_capacity":50,"pod_cidr":"10.244.0.0/16","pod_cidr_prefix_length":26,"service_cidr":"10.0.0.0/16","total_nodes":1}`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::k8s_network_plan(%s))
+							}
+						`, testCase.inputs),
+						Check: resource.TestCheckOutput("result", testCase.plan),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestK8sNetworkPlanFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		inputs string
+		error  string
+	}{
+		"inputs-not-an-object": {
+			inputs: `"10.0.0.0/16"`,
+			error:  `(?s)Call to function "provider::iactools::k8s_network_plan" failed.*inputs\s+must\s+be\s+an\s+object`,
+		},
+		"missing-node-count": {
+			inputs: `{ max_pods = 30 }`,
+			error:  `(?s)Call to function "provider::iactools::k8s_network_plan" failed.*inputs.node_count\s+must\s+be\s+a\s+whole\s+number`,
+		},
+		"unsupported-attribute": {
+			inputs: `{ node_count = 3, pods = 30 }`,
+			error:  `(?s)Call to function "provider::iactools::k8s_network_plan" failed.*unsupported\s+attributes:\s+\[pods\]`,
+		},
+		"too-many-nodes": {
+			inputs: `{ cloud = "gcp", node_count = 15000 }`,
+			error:  `(?s)Call to function "provider::iactools::k8s_network_plan" failed.*node_count\s+and\s+surge_nodes\s+must\s+not\s+exceed\s+15000\s+nodes\s+together`,
+		},
+		"negative-node-cidr-mask-size": {
+			inputs: `{ node_count = 3, node_cidr_mask_size = -24 }`,
+			error:  `(?s)Call to function "provider::iactools::k8s_network_plan" failed.*node_cidr_mask_size\s+must\s+not\s+be\s+negative`,
+		},
+		"unknown-plugin": {
+			inputs: `{ node_count = 3, network_plugin = "flannel" }`,
+			error:  `(?s)Call to function "provider::iactools::k8s_network_plan" failed.*unknown\s+network\s+plugin\s+"flannel"`,
+		},
+		"node-subnet-too-small": {
+			inputs: `{ network_plugin = "vnet", node_count = 10, max_pods = 30, node_subnet_cidr = "10.1.0.0/24", service_cidr = "172.16.0.0/16" }`,
+			error:  `(?s)Call to function "provider::iactools::k8s_network_plan" failed.*node\s+subnet\s+10.1.0.0/24\s+has\s+251\s+usable\s+addresses,\s+11\s+nodes\s+including\s+1\s+surge\s+nodes\s+need\s+341,\s+a\s+/23`,
+		},
+		"pod-cidr-too-small": {
+			inputs: `{ node_count = 300, pod_cidr = "10.244.0.0/16" }`,
+			error:  `(?s)Call to function "provider::iactools::k8s_network_plan" failed.*pod\s+CIDR\s+10.244.0.0/16\s+has\s+256\s+per-node\s+/24\s+pod\s+ranges,\s+301\s+nodes\s+including\s+1\s+surge\s+nodes\s+need\s+a\s+/15`,
+		},
+		"max-pods-limit": {
+			inputs: `{ node_count = 3, max_pods = 300 }`,
+			error:  `(?s)Call to function "provider::iactools::k8s_network_plan" failed.*max_pods\s+300\s+exceeds\s+the\s+limit\s+of\s+250\s+pods\s+per\s+node\s+on\s+azure`,
+		},
+		"kubenet-node-limit": {
+			inputs: `{ network_plugin = "kubenet", node_count = 400, pod_cidr = "10.244.0.0/14" }`,
+			error:  `(?s)Call to function "provider::iactools::k8s_network_plan" failed.*limit\s+of\s+400\s+kubenet\s+nodes\s+on\s+azure`,
+		},
+		"service-cidr-overlaps-parent": {
+			inputs: `{ node_count = 3, parent_cidr = "10.0.0.0/8" }`,
+			error:  `(?s)Call to function "provider::iactools::k8s_network_plan" failed.*pod\s+CIDR\s+10.244.0.0/16\s+overlaps\s+parent\s+10.0.0.0/8.*service\s+CIDR\s+10.0.0.0/16\s+overlaps\s+parent\s+10.0.0.0/8`,
+		},
+		"service-cidr-too-large": {
+			inputs: `{ node_count = 3, service_cidr = "172.0.0.0/8" }`,
+			error:  `(?s)Call to function "provider::iactools::k8s_network_plan" failed.*service\s+CIDR\s+172.0.0.0/8\s+is\s+larger\s+than\s+the\s+/12\s+kube-apiserver\s+accepts`,
+		},
+		"parent-exhausted": {
+			inputs: `{ node_count = 20, parent_cidr = "10.1.0.0/26", reserved_cidrs = ["10.1.0.0/28", "10.1.0.32/28"] }`,
+			error:  `(?s)Call to function "provider::iactools::k8s_network_plan" failed.*parent\s+10.1.0.0/26\s+has\s+no\s+free\s+/27\s+for\s+the\s+node\s+subnet`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::k8s_network_plan(%s)
+							}
+						`, testCase.inputs),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// MAC address formats.
+const (
+	macFormatColon = "colon"
+	macFormatDash  = "dash"
+	macFormatDot   = "dot"
+	macFormatBare  = "bare"
+)
+
+// macUniversalLocalBit is the universal/local bit of the first MAC address byte, flipped in the EUI-64 interface identifier.
+const macUniversalLocalBit = 0x02
+
+// ipv6LinkLocalPrefix is the prefix of the IPv6 link-local addresses.
+const ipv6LinkLocalPrefix = "fe80::/64"
+
+// MACNormalize formats a MAC address in the colon (aa:bb:cc:dd:ee:ff), dash (aa-bb-cc-dd-ee-ff),
+// Cisco dot (aabb.ccdd.eeff) or bare (aabbccddeeff) form, in lower case.
+func MACNormalize(mac, format string) (string, error) {
+	hardwareAddr, err := parseMAC(mac)
+	if err != nil {
+		return "", err
+	}
+
+	bare := hex.EncodeToString(hardwareAddr)
+	switch format {
+	case "", macFormatColon:
+		return hardwareAddr.String(), nil
+	case macFormatDash:
+		return strings.ReplaceAll(hardwareAddr.String(), ":", "-"), nil
+	case macFormatDot:
+		return strings.Join(chunkString(bare, 4), "."), nil
+	case macFormatBare:
+		return bare, nil
+	default:
+		return "", fmt.Errorf("unknown format %q, must be one of %s, %s, %s or %s", format, macFormatColon, macFormatDash, macFormatDot, macFormatBare)
+	}
+}
+
+// IPv6EUI64 calculates the SLAAC address of a MAC address in an IPv6 /64 prefix,
+// using the modified EUI-64 interface identifier as the last 64 bits of the address.
+func IPv6EUI64(prefix, mac string) (string, error) {
+	ip, ipnet, err := net.ParseCIDR(prefix)
+	if err != nil {
+		return "", fmt.Errorf("invalid CIDR: %v", err)
+	}
+	ones, bits := ipnet.Mask.Size()
+	if ip.To4() != nil || bits != 8*net.IPv6len {
+		return "", fmt.Errorf("prefix %s is not an IPv6 prefix", prefix)
+	}
+	if ones != 64 {
+		return "", fmt.Errorf("prefix %s is not a /64, SLAAC only uses /64 prefixes followed by the 64-bit interface identifier", prefix)
+	}
+	hardwareAddr, err := parseMAC(mac)
+	if err != nil {
+		return "", err
+	}
+
+	address := make(net.IP, net.IPv6len)
+	copy(address, ipnet.IP)
+	copy(address[8:], eui64InterfaceID(hardwareAddr))
+	return address.String(), nil
+}
+
+// IPv6EUI64ToMAC recovers the MAC address from the modified EUI-64 interface identifier of an IPv6 address.
+func IPv6EUI64ToMAC(ipAddress string) (string, error) {
+	ip := net.ParseIP(ipAddress)
+	if ip == nil || ip.To4() != nil {
+		return "", fmt.Errorf("invalid IPv6 address: %s", ipAddress)
+	}
+	if ip[11] != 0xff || ip[12] != 0xfe {
+		return "", fmt.Errorf("%s is not an EUI-64 address: the interface identifier doesn't contain ff:fe", ipAddress)
+	}
+
+	hardwareAddr := net.HardwareAddr{ip[8] ^ macUniversalLocalBit, ip[9], ip[10], ip[13], ip[14], ip[15]}
+	return hardwareAddr.String(), nil
+}
+
+// IPv6LinkLocal calculates the EUI-64 link-local address of a MAC address.
+func IPv6LinkLocal(mac string) (string, error) {
+	return IPv6EUI64(ipv6LinkLocalPrefix, mac)
+}
+
+// Helper functions
+
+// parseMAC parses a 48-bit MAC address in colon, dash, Cisco dot or bare form.
+func parseMAC(mac string) (net.HardwareAddr, error) {
+	value := strings.TrimSpace(mac)
+	if len(value) == 12 {
+		if decoded, err := hex.DecodeString(value); err == nil {
+			return net.HardwareAddr(decoded), nil
+		}
+	}
+
+	hardwareAddr, err := net.ParseMAC(value)
+	if err != nil || len(hardwareAddr) != 6 {
+		return nil, fmt.Errorf("invalid MAC address: %s", mac)
+	}
+	return hardwareAddr, nil
+}
+
+// eui64InterfaceID returns the modified EUI-64 interface identifier of a MAC address,
+// inserting ff:fe in the middle and flipping the universal/local bit.
+func eui64InterfaceID(hardwareAddr net.HardwareAddr) []byte {
+	return []byte{
+		hardwareAddr[0] ^ macUniversalLocalBit, hardwareAddr[1], hardwareAddr[2],
+		0xff, 0xfe,
+		hardwareAddr[3], hardwareAddr[4], hardwareAddr[5],
+	}
+}
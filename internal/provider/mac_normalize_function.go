@@ -0,0 +1,81 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = MACNormalizeFunction{}
+)
+
+// NewMACNormalizeFunction is a helper function to create a new instance of MACNormalizeFunction.
+func NewMACNormalizeFunction() function.Function {
+	return MACNormalizeFunction{}
+}
+
+// MACNormalizeFunction is the struct for the MAC normalize function.
+type MACNormalizeFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f MACNormalizeFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "mac_normalize"
+}
+
+// Definition sets the definition for the function.
+func (f MACNormalizeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Normalize the format of a MAC address",
+		MarkdownDescription: "Accepts a 48-bit MAC address in colon, dash, Cisco dot or bare form in any case, and outputs it in lower case in the requested form. " +
+			"Use `upper` for the upper case forms some platforms expect.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "mac_address",
+				MarkdownDescription: "The MAC address",
+			},
+			function.StringParameter{
+				Name: "format",
+				MarkdownDescription: "The output format: `colon` for `00:0d:3a:12:34:56` (the default), `dash` for `00-0d-3a-12-34-56`, " +
+					"`dot` for the Cisco form `000d.3a12.3456` or `bare` for `000d3a123456`. Can be null.",
+				AllowNullValue: true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run executes the MAC normalize function.
+func (f MACNormalizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var macAddress string
+	var format *string
+
+	// Parse the arguments
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &macAddress, &format))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input argument
+	if macAddress == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The mac_address argument must be provided and valid"))
+		return
+	}
+
+	// Normalize the MAC address
+	result, err := MACNormalize(macAddress, stringValueOrEmpty(format))
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error normalizing MAC address: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.StringValue(result)))
+}
@@ -0,0 +1,102 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestMACNormalizeFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		result    string
+	}{
+		"default": {
+			arguments: `"00-0D-3A-12-34-56", null`,
+			result:    `"00:0d:3a:12:34:56"`,
+		},
+		"dash": {
+			arguments: `"000d.3a12.3456", "dash"`,
+			result:    `"00-0d-3a-12-34-56"`,
+		},
+		"dot": {
+			arguments: `"00:0d:3a:12:34:56", "dot"`,
+			result:    `"000d.3a12.3456"`,
+		},
+		"bare": {
+			arguments: `"00:0D:3A:12:34:56", "bare"`,
+			result:    `"000d3a123456"`,
+		},
+		"colon-from-bare": {
+			arguments: `"000d3a123456", "colon"`,
+			result:    `"00:0d:3a:12:34:56"`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::mac_normalize(%s))
+							}
+						`, testCase.arguments),
+						Check: resource.TestCheckOutput("result", testCase.result),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestMACNormalizeFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		error     string
+	}{
+		"empty-mac-address": {
+			arguments: `"", null`,
+			error:     `(?s)Call to function "provider::iactools::mac_normalize" failed.*The\s+mac_address\s+argument\s+must\s+be\s+provided\s+and\s+valid`,
+		},
+		"invalid-mac": {
+			arguments: `"00:0d:3a:12:34:5g", null`,
+			error:     `(?s)Call to function "provider::iactools::mac_normalize" failed.*invalid\s+MAC\s+address:\s+00:0d:3a:12:34:5g`,
+		},
+		"unknown-format": {
+			arguments: `"00:0d:3a:12:34:56", "cisco"`,
+			error:     `(?s)Call to function "provider::iactools::mac_normalize" failed.*unknown\s+format\s+"cisco"`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::mac_normalize(%s)
+							}
+						`, testCase.arguments),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = NameShortenFunction{}
+)
+
+// NewNameShortenFunction is a helper function to create a new instance of NameShortenFunction.
+func NewNameShortenFunction() function.Function {
+	return NameShortenFunction{}
+}
+
+// NameShortenFunction is the struct for the name shorten function.
+type NameShortenFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f NameShortenFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "name_shorten"
+}
+
+// Definition sets the definition for the function.
+func (f NameShortenFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Shorten a name to a maximum length while keeping it unique",
+		MarkdownDescription: "Outputs names that fit in `max` characters unchanged. " +
+			"Longer names are cut to a readable prefix followed by the first 6 `base36` characters of the SHA-256 hash of the whole name, " +
+			"the same suffix `stable_suffix([name], 6, \"base36\")` outputs, so two long names with the same prefix still get different short names. " +
+			"The prefix and the hash are separated by the first of `-`, `_` or `.` found in the name, or by nothing when the name has none of them, " +
+			"and separators at the end of the prefix are removed.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "The name to shorten",
+			},
+			function.Int64Parameter{
+				Name:                "max",
+				MarkdownDescription: "The maximum length of the name in characters",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run executes the name shorten function.
+func (f NameShortenFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+	var maxLength int64
+
+	// Parse the arguments
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &name, &maxLength))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input arguments
+	if name == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The name argument must be provided and valid"))
+		return
+	}
+
+	// Shorten the name
+	result, err := NameShorten(name, int(maxLength))
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error shortening name: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.StringValue(result)))
+}
@@ -0,0 +1,102 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestNameShortenFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		result    string
+	}{
+		"fits": {
+			arguments: `"payments", 10`,
+			result:    `"payments"`,
+		},
+		"hyphen": {
+			arguments: `"payments-processing-service-production", 24`,
+			result:    `"payments-processi-l3kg1n"`,
+		},
+		"underscore": {
+			arguments: `"payments_processing_service", 20`,
+			result:    `"payments_proc_fx9tjp"`,
+		},
+		"no-separator": {
+			arguments: `"paymentsprocessingservice", 12`,
+			result:    `"paymen3sgm4h"`,
+		},
+		"single-character-prefix": {
+			arguments: `"a-bcdefghij", 8`,
+			result:    `"a-pvl04n"`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::name_shorten(%s))
+							}
+						`, testCase.arguments),
+						Check: resource.TestCheckOutput("result", testCase.result),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestNameShortenFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		error     string
+	}{
+		"empty-name": {
+			arguments: `"", 10`,
+			error:     `(?s)Call to function "provider::iactools::name_shorten" failed.*The\s+name\s+argument\s+must\s+be\s+provided\s+and\s+valid`,
+		},
+		"short-max": {
+			arguments: `"abcdefghij", 6`,
+			error:     `(?s)Call to function "provider::iactools::name_shorten" failed.*the\s+maximum\s+length\s+must\s+be\s+at\s+least\s+7\s+to\s+keep\s+a\s+prefix\s+of\s+the\s+name\s+and\s+the\s+hash`,
+		},
+		"only-separators": {
+			arguments: `"-----------", 9`,
+			error:     `(?s)Call to function "provider::iactools::name_shorten" failed.*the\s+name\s+"-----------"\s+has\s+no\s+characters\s+other\s+than\s+separators\s+to\s+keep`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::name_shorten(%s)
+							}
+						`, testCase.arguments),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+)
+
+// nat64WellKnownPrefix is the well-known prefix of RFC 6052 for IPv4-embedded IPv6 addresses.
+const nat64WellKnownPrefix = "64:ff9b::/96"
+
+// nat64PrefixLengths are the prefix lengths of RFC 6052 for IPv4-embedded IPv6 addresses.
+var nat64PrefixLengths = []int{32, 40, 48, 56, 64, 96}
+
+// nat64UOctet is the byte of the IPv6 address reserved by RFC 6052 (bits 64 to 71), skipped when embedding the IPv4 address.
+const nat64UOctet = 8
+
+// DNS64Record holds the AAAA value synthesized by DNS64 for an IPv4 address and its reverse DNS name.
+type DNS64Record struct {
+	AAAA    string `tfsdk:"aaaa"`
+	PTRName string `tfsdk:"ptr_name"`
+}
+
+// NAT64Embed embeds an IPv4 address in an IPv6 prefix following RFC 6052, using the well-known prefix when the prefix is empty.
+func NAT64Embed(ipv4Address, prefix string) (string, error) {
+	ip, err := nat64Embed(ipv4Address, prefix)
+	if err != nil {
+		return "", err
+	}
+	return ip.String(), nil
+}
+
+// NAT64Extract extracts the IPv4 address embedded in an IPv6 address with the RFC 6052 prefix length.
+func NAT64Extract(ipv6Address string, prefixLength int64) (string, error) {
+	ip := net.ParseIP(strings.TrimSpace(ipv6Address))
+	if ip == nil || !strings.Contains(ipv6Address, ":") {
+		return "", fmt.Errorf("invalid IPv6 address: %s", ipv6Address)
+	}
+	if !slices.Contains(nat64PrefixLengths, int(prefixLength)) {
+		return "", fmt.Errorf("invalid prefix length %d, must be one of %s", prefixLength, formatNAT64PrefixLengths())
+	}
+	if prefixLength != 96 && ip[nat64UOctet] != 0 {
+		return "", fmt.Errorf("%s is not an IPv4-embedded IPv6 address with a /%d prefix: bits 64 to 71 must be zero", ipv6Address, prefixLength)
+	}
+
+	ipv4 := make(net.IP, 0, net.IPv4len)
+	for i := int(prefixLength) / 8; len(ipv4) < net.IPv4len; i++ {
+		if i != nat64UOctet {
+			ipv4 = append(ipv4, ip[i])
+		}
+	}
+	return ipv4.String(), nil
+}
+
+// DNS64Synthesize returns the AAAA value DNS64 synthesizes for an IPv4 address with the prefix, and its ip6.arpa name.
+func DNS64Synthesize(ipv4Address, prefix string) (DNS64Record, error) {
+	ip, err := nat64Embed(ipv4Address, prefix)
+	if err != nil {
+		return DNS64Record{}, err
+	}
+	return DNS64Record{AAAA: ip.String(), PTRName: ReverseDNSIPv6(ip)}, nil
+}
+
+// Helper functions
+
+// nat64Embed embeds an IPv4 address in an IPv6 prefix, skipping the u octet for prefixes shorter than /96.
+// The well-known prefix can only be used with globally reachable IPv4 addresses (RFC 6052 section 3.1).
+func nat64Embed(ipv4Address, prefix string) (net.IP, error) {
+	ipv4 := net.ParseIP(strings.TrimSpace(ipv4Address)).To4()
+	if ipv4 == nil || strings.Contains(ipv4Address, ":") {
+		return nil, fmt.Errorf("invalid IPv4 address: %s", ipv4Address)
+	}
+	if prefix == "" {
+		prefix = nat64WellKnownPrefix
+	}
+	_, ipnet, err := net.ParseCIDR(strings.TrimSpace(prefix))
+	if err != nil {
+		return nil, fmt.Errorf("invalid prefix: %v", err)
+	}
+	ones, bits := ipnet.Mask.Size()
+	if bits != 8*net.IPv6len {
+		return nil, fmt.Errorf("prefix %s is not an IPv6 prefix", prefix)
+	}
+	if !slices.Contains(nat64PrefixLengths, ones) {
+		return nil, fmt.Errorf("invalid prefix length /%d, must be one of %s", ones, formatNAT64PrefixLengths())
+	}
+	if ipnet.String() == nat64WellKnownPrefix && !nat64GloballyReachable(ipv4) {
+		return nil, fmt.Errorf("the well-known prefix %s must not be used with the non-global IPv4 address %s, use a network-specific prefix", nat64WellKnownPrefix, ipv4Address)
+	}
+
+	ip := make(net.IP, net.IPv6len)
+	copy(ip, ipnet.IP)
+	position := ones / 8
+	for _, b := range ipv4 {
+		if position == nat64UOctet {
+			position++
+		}
+		ip[position] = b
+		position++
+	}
+	return ip, nil
+}
+
+// nat64GloballyReachable reports whether an IPv4 address is globally reachable,
+// based on the most specific entry of the IANA special-purpose registry containing it.
+func nat64GloballyReachable(ipv4 net.IP) bool {
+	matches, err := CIDRClassify(ipv4.String())
+	if err != nil {
+		return false
+	}
+
+	reachable, ones := true, -1
+	for _, match := range matches {
+		if match.Registry != specialPurposeRegistryIANA || match.GloballyReachable == nil {
+			continue
+		}
+		_, ipnet, _ := net.ParseCIDR(match.CIDR)
+		if entryOnes, _ := ipnet.Mask.Size(); entryOnes > ones {
+			reachable, ones = *match.GloballyReachable, entryOnes
+		}
+	}
+	return reachable
+}
+
+// formatNAT64PrefixLengths formats the RFC 6052 prefix lengths for the error messages.
+func formatNAT64PrefixLengths() string {
+	lengths := make([]string, 0, len(nat64PrefixLengths))
+	for _, length := range nat64PrefixLengths {
+		lengths = append(lengths, fmt.Sprintf("/%d", length))
+	}
+	return strings.Join(lengths, ", ")
+}
@@ -0,0 +1,82 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = NAT64EmbedFunction{}
+)
+
+// NewNAT64EmbedFunction is a helper function to create a new instance of NAT64EmbedFunction.
+func NewNAT64EmbedFunction() function.Function {
+	return NAT64EmbedFunction{}
+}
+
+// NAT64EmbedFunction is the struct for the NAT64 embed function.
+type NAT64EmbedFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f NAT64EmbedFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "nat64_embed"
+}
+
+// Definition sets the definition for the function.
+func (f NAT64EmbedFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Embed an IPv4 address in a NAT64 IPv6 prefix",
+		MarkdownDescription: "Outputs the IPv4-embedded IPv6 address of RFC 6052 that NAT64 clients use to reach an IPv4 destination, e.g. `64:ff9b::c000:201` for `192.0.2.1`. " +
+			"The prefix is the well-known prefix `64:ff9b::/96` or a network-specific prefix with the length /32, /40, /48, /56, /64 or /96. " +
+			"With prefixes shorter than /96 the bits 64 to 71 of the address are skipped and left zero. " +
+			"The well-known prefix can't be used with non-global IPv4 addresses like the private ranges of RFC 1918.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "ipv4_address",
+				MarkdownDescription: "The IPv4 address to embed",
+			},
+			function.StringParameter{
+				Name:                "prefix",
+				MarkdownDescription: "The NAT64 prefix, defaults to the well-known prefix `64:ff9b::/96` when null",
+				AllowNullValue:      true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run executes the NAT64 embed function.
+func (f NAT64EmbedFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ipv4Address string
+	var prefix *string
+
+	// Parse the arguments
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &ipv4Address, &prefix))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input arguments
+	if ipv4Address == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The ipv4_address argument must be provided and valid"))
+		return
+	}
+
+	// Embed the IPv4 address
+	result, err := NAT64Embed(ipv4Address, stringValueOrEmpty(prefix))
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error embedding IPv4 address: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.StringValue(result)))
+}
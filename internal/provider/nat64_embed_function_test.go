@@ -0,0 +1,130 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestNAT64EmbedFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		result    string
+	}{
+		"well-known-prefix": {
+			arguments: `"8.8.8.8", null`,
+			result:    `"64:ff9b::808:808"`,
+		},
+		"well-known-prefix-explicit": {
+			arguments: `"8.8.8.8", "64:ff9b::/96"`,
+			result:    `"64:ff9b::808:808"`,
+		},
+		"prefix-32": {
+			arguments: `"192.0.2.33", "2001:db8::/32"`,
+			result:    `"2001:db8:c000:221::"`,
+		},
+		"prefix-40": {
+			arguments: `"192.0.2.33", "2001:db8:100::/40"`,
+			result:    `"2001:db8:1c0:2:21::"`,
+		},
+		"prefix-48": {
+			arguments: `"192.0.2.33", "2001:db8:122::/48"`,
+			result:    `"2001:db8:122:c000:2:2100::"`,
+		},
+		"prefix-56": {
+			arguments: `"192.0.2.33", "2001:db8:122:300::/56"`,
+			result:    `"2001:db8:122:3c0:0:221::"`,
+		},
+		"prefix-64": {
+			arguments: `"192.0.2.33", "2001:db8:122:344::/64"`,
+			result:    `"2001:db8:122:344:c0:2:2100:0"`,
+		},
+		"prefix-96": {
+			arguments: `"192.0.2.33", "2001:db8:122:344::/96"`,
+			result:    `"2001:db8:122:344::c000:221"`,
+		},
+		"private-network-specific": {
+			arguments: `"10.1.2.3", "64:ff9b:1::/96"`,
+			result:    `"64:ff9b:1::a01:203"`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::nat64_embed(%s))
+							}
+						`, testCase.arguments),
+						Check: resource.TestCheckOutput("result", testCase.result),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestNAT64EmbedFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		error     string
+	}{
+		"empty": {
+			arguments: `"", null`,
+			error:     `(?s)Call to function "provider::iactools::nat64_embed" failed.*The\s+ipv4_address\s+argument\s+must\s+be\s+provided\s+and\s+valid`,
+		},
+		"ipv6-address": {
+			arguments: `"2001:db8::1", null`,
+			error:     `(?s)Call to function "provider::iactools::nat64_embed" failed.*Error\s+embedding\s+IPv4\s+address:\s+invalid\s+IPv4\s+address:\s+2001:db8::1`,
+		},
+		"invalid-prefix": {
+			arguments: `"8.8.8.8", "64:ff9b::"`,
+			error:     `(?s)Call to function "provider::iactools::nat64_embed" failed.*Error\s+embedding\s+IPv4\s+address:\s+invalid\s+prefix`,
+		},
+		"ipv4-prefix": {
+			arguments: `"8.8.8.8", "10.0.0.0/8"`,
+			error:     `(?s)Call to function "provider::iactools::nat64_embed" failed.*prefix\s+10.0.0.0/8\s+is\s+not\s+an\s+IPv6\s+prefix`,
+		},
+		"prefix-length": {
+			arguments: `"8.8.8.8", "2001:db8::/36"`,
+			error:     `(?s)Call to function "provider::iactools::nat64_embed" failed.*invalid\s+prefix\s+length\s+/36,\s+must\s+be\s+one\s+of\s+/32,\s+/40,\s+/48,\s+/56,\s+/64,\s+/96`,
+		},
+		"well-known-private": {
+			arguments: `"10.1.2.3", null`,
+			error:     `(?s)Call to function "provider::iactools::nat64_embed" failed.*the\s+well-known\s+prefix\s+64:ff9b::/96\s+must\s+not\s+be\s+used\s+with\s+the\s+non-global\s+IPv4\s+address\s+10.1.2.3,\s+use\s+a\s+network-specific\s+prefix`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::nat64_embed(%s)
+							}
+						`, testCase.arguments),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = NAT64ExtractFunction{}
+)
+
+// NewNAT64ExtractFunction is a helper function to create a new instance of NAT64ExtractFunction.
+func NewNAT64ExtractFunction() function.Function {
+	return NAT64ExtractFunction{}
+}
+
+// NAT64ExtractFunction is the struct for the NAT64 extract function.
+type NAT64ExtractFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f NAT64ExtractFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "nat64_extract"
+}
+
+// Definition sets the definition for the function.
+func (f NAT64ExtractFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Extract the IPv4 address of a NAT64 IPv6 address",
+		MarkdownDescription: "Outputs the IPv4 address embedded in an IPv4-embedded IPv6 address of RFC 6052, e.g. `192.0.2.1` for `64:ff9b::c000:201` with the prefix length 96. " +
+			"The prefix length must be 32, 40, 48, 56, 64 or 96, and for prefix lengths shorter than 96 the bits 64 to 71 of the address must be zero.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "ipv6_address",
+				MarkdownDescription: "The IPv4-embedded IPv6 address",
+			},
+			function.Int64Parameter{
+				Name:                "prefix_length",
+				MarkdownDescription: "The length of the NAT64 prefix",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run executes the NAT64 extract function.
+func (f NAT64ExtractFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ipv6Address string
+	var prefixLength int64
+
+	// Parse the arguments
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &ipv6Address, &prefixLength))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input arguments
+	if ipv6Address == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The ipv6_address argument must be provided and valid"))
+		return
+	}
+
+	// Extract the IPv4 address
+	result, err := NAT64Extract(ipv6Address, prefixLength)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error extracting IPv4 address: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.StringValue(result)))
+}
@@ -0,0 +1,114 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestNAT64ExtractFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		result    string
+	}{
+		"well-known-prefix": {
+			arguments: `"64:ff9b::808:808", 96`,
+			result:    `"8.8.8.8"`,
+		},
+		"dotted": {
+			arguments: `"64:ff9b::8.8.4.4", 96`,
+			result:    `"8.8.4.4"`,
+		},
+		"prefix-32": {
+			arguments: `"2001:db8:c000:221::", 32`,
+			result:    `"192.0.2.33"`,
+		},
+		"prefix-40": {
+			arguments: `"2001:db8:1c0:2:21::", 40`,
+			result:    `"192.0.2.33"`,
+		},
+		"prefix-48": {
+			arguments: `"2001:db8:122:c000:2:2100::", 48`,
+			result:    `"192.0.2.33"`,
+		},
+		"prefix-56": {
+			arguments: `"2001:db8:122:3c0:0:221::", 56`,
+			result:    `"192.0.2.33"`,
+		},
+		"prefix-64": {
+			arguments: `"2001:db8:122:344:c0:2:2100:0", 64`,
+			result:    `"192.0.2.33"`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::nat64_extract(%s))
+							}
+						`, testCase.arguments),
+						Check: resource.TestCheckOutput("result", testCase.result),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestNAT64ExtractFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		error     string
+	}{
+		"empty": {
+			arguments: `"", 96`,
+			error:     `(?s)Call to function "provider::iactools::nat64_extract" failed.*The\s+ipv6_address\s+argument\s+must\s+be\s+provided\s+and\s+valid`,
+		},
+		"ipv4-address": {
+			arguments: `"8.8.8.8", 96`,
+			error:     `(?s)Call to function "provider::iactools::nat64_extract" failed.*Error\s+extracting\s+IPv4\s+address:\s+invalid\s+IPv6\s+address:\s+8.8.8.8`,
+		},
+		"prefix-length": {
+			arguments: `"64:ff9b::808:808", 80`,
+			error:     `(?s)Call to function "provider::iactools::nat64_extract" failed.*invalid\s+prefix\s+length\s+80,\s+must\s+be\s+one\s+of\s+/32,\s+/40,\s+/48,\s+/56,\s+/64,\s+/96`,
+		},
+		"u-octet": {
+			arguments: `"2001:db8:122:344:ff00::", 48`,
+			error:     `(?s)Call to function "provider::iactools::nat64_extract" failed.*2001:db8:122:344:ff00::\s+is\s+not\s+an\s+IPv4-embedded\s+IPv6\s+address\s+with\s+a\s+/48\s+prefix:\s+bits\s+64\s+to\s+71\s+must\s+be\s+zero`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::nat64_extract(%s)
+							}
+						`, testCase.arguments),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
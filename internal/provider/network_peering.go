@@ -0,0 +1,231 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Network peering topologies.
+const (
+	peeringTopologyFullMesh = "full_mesh"
+	peeringTopologyHubSpoke = "hub_spoke"
+	peeringTopologyMultiHub = "multi_hub"
+)
+
+// Network roles.
+const (
+	networkRoleHub    = "hub"
+	networkRoleSpoke  = "spoke"
+	networkRoleShared = "shared"
+)
+
+// Network peering types.
+const (
+	peeringTypeMesh     = "mesh"
+	peeringTypeHubHub   = "hub_hub"
+	peeringTypeHubSpoke = "hub_spoke"
+	peeringTypeShared   = "shared"
+)
+
+// PeeringNetwork holds a network and its role in the peering topology.
+type PeeringNetwork struct {
+	Name              string
+	Role              string
+	Region            string
+	Hub               string
+	Gateway           bool
+	UseRemoteGateways *bool
+}
+
+// PeeringDirection holds the options of the peering from one network to the other.
+type PeeringDirection struct {
+	AllowVirtualNetworkAccess bool `tfsdk:"allow_virtual_network_access"`
+	AllowForwardedTraffic     bool `tfsdk:"allow_forwarded_traffic"`
+	AllowGatewayTransit       bool `tfsdk:"allow_gateway_transit"`
+	UseRemoteGateways         bool `tfsdk:"use_remote_gateways"`
+}
+
+// PeeringPair holds a pair of peered networks with the options of both directions.
+type PeeringPair struct {
+	Local         string           `tfsdk:"local"`
+	Remote        string           `tfsdk:"remote"`
+	Type          string           `tfsdk:"type"`
+	CrossRegion   bool             `tfsdk:"cross_region"`
+	LocalToRemote PeeringDirection `tfsdk:"local_to_remote"`
+	RemoteToLocal PeeringDirection `tfsdk:"remote_to_local"`
+}
+
+// NetworkPeeringMatrix calculates the peerings of the networks in a full mesh, hub-spoke or multi-hub topology.
+// In the hub-spoke topologies hubs are listed as the local network of their pairs,
+// spokes are peered with their hub, hubs are peered with each other, and shared networks are peered with every network.
+func NetworkPeeringMatrix(networks []PeeringNetwork, topology string) ([]PeeringPair, error) {
+	networks = slices.Clone(networks)
+	sort.SliceStable(networks, func(i, j int) bool {
+		return networks[i].Name < networks[j].Name
+	})
+
+	byName := make(map[string]PeeringNetwork, len(networks))
+	var hubs []PeeringNetwork
+	for i, network := range networks {
+		switch network.Role {
+		case "":
+			network.Role = networkRoleSpoke
+			networks[i] = network
+		case networkRoleHub, networkRoleSpoke, networkRoleShared:
+		default:
+			return nil, fmt.Errorf("invalid role %q of network %q: must be hub, spoke or shared", network.Role, network.Name)
+		}
+		if _, ok := byName[network.Name]; ok {
+			return nil, fmt.Errorf("duplicate network name %q", network.Name)
+		}
+		byName[network.Name] = network
+		if network.Role == networkRoleHub {
+			hubs = append(hubs, network)
+		}
+	}
+
+	pairs := make(map[string]PeeringPair)
+	addPair := func(local, remote PeeringNetwork, pairType string) {
+		key := local.Name + "\x00" + remote.Name
+		if local.Name > remote.Name {
+			key = remote.Name + "\x00" + local.Name
+		}
+		if _, ok := pairs[key]; !ok {
+			pairs[key] = newPeeringPair(local, remote, pairType)
+		}
+	}
+
+	switch topology {
+	case peeringTopologyFullMesh:
+		for _, local := range networks {
+			for _, remote := range networks {
+				if local.Name < remote.Name {
+					addPair(local, remote, peeringTypeMesh)
+				}
+			}
+		}
+	case peeringTopologyHubSpoke, peeringTopologyMultiHub:
+		if topology == peeringTopologyHubSpoke && len(hubs) != 1 {
+			return nil, fmt.Errorf("the hub_spoke topology requires exactly one hub network, found %d, use the multi_hub topology for more hubs", len(hubs))
+		}
+		if len(hubs) == 0 {
+			return nil, fmt.Errorf("the multi_hub topology requires at least one hub network")
+		}
+
+		for _, local := range hubs {
+			for _, remote := range hubs {
+				if local.Name < remote.Name {
+					addPair(local, remote, peeringTypeHubHub)
+				}
+			}
+		}
+		for _, network := range networks {
+			switch network.Role {
+			case networkRoleSpoke:
+				hub, err := peeringHub(network, hubs, byName)
+				if err != nil {
+					return nil, err
+				}
+				if network.UseRemoteGateways != nil && *network.UseRemoteGateways {
+					if !hub.Gateway {
+						return nil, fmt.Errorf("spoke %q sets use_remote_gateways but its hub %q has no gateway", network.Name, hub.Name)
+					}
+					if network.Gateway {
+						return nil, fmt.Errorf("spoke %q sets use_remote_gateways but has a gateway of its own, it can't use the gateway of hub %q", network.Name, hub.Name)
+					}
+				}
+				addPair(hub, network, peeringTypeHubSpoke)
+			case networkRoleShared:
+				for _, remote := range networks {
+					switch {
+					case remote.Name == network.Name:
+					case remote.Role == networkRoleHub, remote.Role == networkRoleShared && remote.Name < network.Name:
+						addPair(remote, network, peeringTypeShared)
+					default:
+						addPair(network, remote, peeringTypeShared)
+					}
+				}
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unknown topology %q, must be one of %s, %s or %s", topology, peeringTopologyFullMesh, peeringTopologyHubSpoke, peeringTopologyMultiHub)
+	}
+
+	result := make([]PeeringPair, 0, len(pairs))
+	for _, pair := range pairs {
+		result = append(result, pair)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Local != result[j].Local {
+			return result[i].Local < result[j].Local
+		}
+		return result[i].Remote < result[j].Remote
+	})
+	return result, nil
+}
+
+// Helper functions
+
+// peeringHub returns the hub of a spoke, either set explicitly or the only hub in the region of the spoke.
+func peeringHub(spoke PeeringNetwork, hubs []PeeringNetwork, byName map[string]PeeringNetwork) (PeeringNetwork, error) {
+	if spoke.Hub != "" {
+		hub, ok := byName[spoke.Hub]
+		if !ok || hub.Role != networkRoleHub {
+			return PeeringNetwork{}, fmt.Errorf("hub %q of spoke %q is not a hub network", spoke.Hub, spoke.Name)
+		}
+		return hub, nil
+	}
+	if len(hubs) == 1 {
+		return hubs[0], nil
+	}
+
+	var candidates []string
+	var match PeeringNetwork
+	for _, hub := range hubs {
+		if spoke.Region != "" && strings.EqualFold(hub.Region, spoke.Region) {
+			candidates = append(candidates, hub.Name)
+			match = hub
+		}
+	}
+	switch len(candidates) {
+	case 1:
+		return match, nil
+	case 0:
+		return PeeringNetwork{}, fmt.Errorf("spoke %q has no hub in region %q, set its hub attribute", spoke.Name, spoke.Region)
+	default:
+		sort.Strings(candidates)
+		return PeeringNetwork{}, fmt.Errorf("spoke %q has multiple hubs in region %q (%s), set its hub attribute", spoke.Name, spoke.Region, strings.Join(candidates, ", "))
+	}
+}
+
+// newPeeringPair creates a peering pair with the direction options of its type.
+func newPeeringPair(local, remote PeeringNetwork, pairType string) PeeringPair {
+	pair := PeeringPair{
+		Local:         local.Name,
+		Remote:        remote.Name,
+		Type:          pairType,
+		CrossRegion:   local.Region != "" && remote.Region != "" && !strings.EqualFold(local.Region, remote.Region),
+		LocalToRemote: PeeringDirection{AllowVirtualNetworkAccess: true},
+		RemoteToLocal: PeeringDirection{AllowVirtualNetworkAccess: true},
+	}
+
+	switch pairType {
+	case peeringTypeHubHub:
+		// Traffic between spokes of different hubs is forwarded by the hubs
+		pair.LocalToRemote.AllowForwardedTraffic = true
+		pair.RemoteToLocal.AllowForwardedTraffic = true
+	case peeringTypeHubSpoke:
+		// The hub forwards traffic of other networks to the spoke, and shares its gateway when it has one
+		pair.LocalToRemote.AllowForwardedTraffic = true
+		pair.RemoteToLocal.AllowForwardedTraffic = true
+		pair.LocalToRemote.AllowGatewayTransit = local.Gateway
+		pair.RemoteToLocal.UseRemoteGateways = local.Gateway && !remote.Gateway && (remote.UseRemoteGateways == nil || *remote.UseRemoteGateways)
+	}
+	return pair
+}
@@ -0,0 +1,170 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = NetworkPeeringMatrixFunction{}
+)
+
+// NewNetworkPeeringMatrixFunction is a helper function to create a new instance of NetworkPeeringMatrixFunction.
+func NewNetworkPeeringMatrixFunction() function.Function {
+	return NetworkPeeringMatrixFunction{}
+}
+
+// NetworkPeeringMatrixFunction is the struct for the network peering matrix function.
+type NetworkPeeringMatrixFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f NetworkPeeringMatrixFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "network_peering_matrix"
+}
+
+// Definition sets the definition for the function.
+func (f NetworkPeeringMatrixFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	peeringDirectionType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"allow_virtual_network_access": types.BoolType,
+			"allow_forwarded_traffic":      types.BoolType,
+			"allow_gateway_transit":        types.BoolType,
+			"use_remote_gateways":          types.BoolType,
+		},
+	}
+
+	resp.Definition = function.Definition{
+		Summary: "Generate the peerings of a full mesh, hub-spoke or multi-hub network topology",
+		MarkdownDescription: "Outputs the de-duplicated list of network pairs to peer, ordered by network name, with the options of the `local_to_remote` and `remote_to_local` peerings. " +
+			"In the `full_mesh` topology every network is peered with every other network. " +
+			"In the `hub_spoke` topology the single hub is peered with every spoke, the `multi_hub` topology peers every hub with every other hub, " +
+			"and every spoke with its hub: the one set in its `hub` attribute, the only hub, or the only hub in its region. " +
+			"Shared networks are peered with every network. " +
+			"Hubs forward traffic, and share their gateway with their spokes when `gateway` is true, spokes use the gateway of their hub unless they have their own gateway or set `use_remote_gateways` to false. " +
+			"Pairs of networks in different regions are marked as `cross_region`.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name: "networks",
+				MarkdownDescription: "The map of networks by name, each an object with the optional attributes `role` (`hub`, `spoke` or `shared`, defaults to `spoke`), `region`, " +
+					"`hub` (the name of the hub of a spoke), `gateway` (whether the network has a VPN or ExpressRoute gateway) and `use_remote_gateways` (defaults to true, setting it to true on a spoke whose hub has no gateway or that has its own gateway is an error)",
+			},
+			function.StringParameter{
+				Name:                "topology",
+				MarkdownDescription: "The topology: `full_mesh`, `hub_spoke` or `multi_hub`",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"local":           types.StringType,
+					"remote":          types.StringType,
+					"type":            types.StringType,
+					"cross_region":    types.BoolType,
+					"local_to_remote": peeringDirectionType,
+					"remote_to_local": peeringDirectionType,
+				},
+			},
+		},
+	}
+}
+
+// Run executes the network peering matrix function.
+func (f NetworkPeeringMatrixFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var networksArgument types.Dynamic
+	var topology string
+
+	// Parse the arguments
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &networksArgument, &topology))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input arguments
+	networks, err := parsePeeringNetworks(networksArgument)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error parsing networks: %s", err.Error())))
+		return
+	}
+	if len(networks) == 0 {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The networks argument must be provided and valid"))
+		return
+	}
+	if topology == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The topology argument must be provided and valid"))
+		return
+	}
+
+	// Calculate the peerings
+	pairs, err := NetworkPeeringMatrix(networks, topology)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error calculating network peerings: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, pairs))
+}
+
+// parsePeeringNetworks converts the networks argument into peering networks.
+func parsePeeringNetworks(value types.Dynamic) ([]PeeringNetwork, error) {
+	converted, err := dynamicToGo(value)
+	if err != nil || converted == nil {
+		return nil, err
+	}
+	objects, err := goObject(converted, "networks")
+	if err != nil {
+		return nil, fmt.Errorf("networks must be a map of network objects by name")
+	}
+
+	networks := make([]PeeringNetwork, 0, len(objects))
+	for name, item := range objects {
+		path := fmt.Sprintf("networks[%q]", name)
+		object, err := goObject(item, path)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkObjectKeys(object, path, "role", "region", "hub", "gateway", "use_remote_gateways"); err != nil {
+			return nil, err
+		}
+
+		network := PeeringNetwork{Name: name}
+		if object["role"] != nil {
+			if network.Role, err = goString(object["role"], path+".role"); err != nil {
+				return nil, err
+			}
+		}
+		if object["region"] != nil {
+			if network.Region, err = goString(object["region"], path+".region"); err != nil {
+				return nil, err
+			}
+		}
+		if object["hub"] != nil {
+			if network.Hub, err = goString(object["hub"], path+".hub"); err != nil {
+				return nil, err
+			}
+		}
+		if object["gateway"] != nil {
+			if network.Gateway, err = goBool(object["gateway"], path+".gateway"); err != nil {
+				return nil, err
+			}
+		}
+		if object["use_remote_gateways"] != nil {
+			useRemoteGateways, err := goBool(object["use_remote_gateways"], path+".use_remote_gateways")
+			if err != nil {
+				return nil, err
+			}
+			network.UseRemoteGateways = &useRemoteGateways
+		}
+		networks = append(networks, network)
+	}
+	return networks, nil
+}
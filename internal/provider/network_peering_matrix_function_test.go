@@ -0,0 +1,188 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestNetworkPeeringMatrixFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		networks string
+		topology string
+		pairs    string
+	}{
+		"full-mesh": {
+			networks: `{ c = {}, a = {}, b = { role = "hub" } }`,
+			topology: "full_mesh",
+			pairs:    `["a:b:mesh:false","a:c:mesh:false","b:c:mesh:false"]`,
+		},
+		"hub-spoke": {
+			networks: `{ hub = { role = "hub", gateway = true }, app = {}, data = { role = "spoke" } }`,
+			topology: "hub_spoke",
+			pairs:    `["hub:app:hub_spoke:false","hub:data:hub_spoke:false"]`,
+		},
+		"multi-hub": {
+			networks: `{
+				hub-weu  = { role = "hub", region = "westeurope" }
+				hub-neu  = { role = "hub", region = "northeurope" }
+				spoke1   = { region = "westeurope" }
+				spoke2   = { region = "northeurope" }
+				spoke3   = { region = "eastus", hub = "hub-weu" }
+				identity = { role = "shared", region = "westeurope" }
+			}`,
+			topology: "multi_hub",
+			pairs: `["hub-neu:hub-weu:hub_hub:true","hub-neu:identity:shared:true","hub-neu:spoke2:hub_spoke:false",` +
+				`"hub-weu:identity:shared:false","hub-weu:spoke1:hub_spoke:false","hub-weu:spoke3:hub_spoke:true",` +
+				`"identity:spoke1:shared:false","identity:spoke2:shared:true","identity:spoke3:shared:true"]`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode([for pair in provider::iactools::network_peering_matrix(%s, %q) : "${pair.local}:${pair.remote}:${pair.type}:${pair.cross_region}"])
+							}
+						`, testCase.networks, testCase.topology),
+						Check: resource.TestCheckOutput("result", testCase.pairs),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestNetworkPeeringMatrixFunction_Options(t *testing.T) {
+	testCases := map[string]struct {
+		networks string
+		pairs    string
+	}{
+		"gateway-transit": {
+			networks: `{ hub = { role = "hub", gateway = true }, app = {} }`,
+			pairs: `[{"cross_region":false,"local":"hub",` +
+				`"local_to_remote":{"allow_forwarded_traffic":true,"allow_gateway_transit":true,"allow_virtual_network_access":true,"use_remote_gateways":false},` +
+				`"remote":"app",` +
+				`"remote_to_local":{"allow_forwarded_traffic":true,"allow_gateway_transit":false,"allow_virtual_network_access":true,"use_remote_gateways":true},` +
+				`"type":"hub_spoke"}]`,
+		},
+		"use-remote-gateways-disabled": {
+			networks: `{ hub = { role = "hub", gateway = true }, app = { use_remote_gateways = false } }`,
+			pairs: `[{"cross_region":false,"local":"hub",` +
+				`"local_to_remote":{"allow_forwarded_traffic":true,"allow_gateway_transit":true,"allow_virtual_network_access":true,"use_remote_gateways":false},` +
+				`"remote":"app",` +
+				`"remote_to_local":{"allow_forwarded_traffic":true,"allow_gateway_transit":false,"allow_virtual_network_access":true,"use_remote_gateways":false},` +
+				`"type":"hub_spoke"}]`,
+		},
+		"hub-without-gateway": {
+			networks: `{ hub = { role = "hub" }, app = {} }`,
+			pairs: `[{"cross_region":false,"local":"hub",` +
+				`"local_to_remote":{"allow_forwarded_traffic":true,"allow_gateway_transit":false,"allow_virtual_network_access":true,"use_remote_gateways":false},` +
+				`"remote":"app",` +
+				`"remote_to_local":{"allow_forwarded_traffic":true,"allow_gateway_transit":false,"allow_virtual_network_access":true,"use_remote_gateways":false},` +
+				`"type":"hub_spoke"}]`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::network_peering_matrix(%s, "hub_spoke"))
+							}
+						`, testCase.networks),
+						Check: resource.TestCheckOutput("result", testCase.pairs),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestNetworkPeeringMatrixFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		networks string
+		topology string
+		error    string
+	}{
+		"empty-networks": {
+			networks: `{}`,
+			topology: "full_mesh",
+			error:    `(?s)Call to function "provider::iactools::network_peering_matrix" failed.*networks\s+argument\s+must\s+be\s+provided\s+and\s+valid`,
+		},
+		"unknown-topology": {
+			networks: `{ a = {}, b = {} }`,
+			topology: "ring",
+			error:    `(?s)Call to function "provider::iactools::network_peering_matrix" failed.*unknown\s+topology\s+"ring"`,
+		},
+		"hub-spoke-with-two-hubs": {
+			networks: `{ a = { role = "hub" }, b = { role = "hub" } }`,
+			topology: "hub_spoke",
+			error:    `(?s)Call to function "provider::iactools::network_peering_matrix" failed.*requires\s+exactly\s+one\s+hub\s+network,\s+found\s+2`,
+		},
+		"use-remote-gateways-without-hub-gateway": {
+			networks: `{ hub = { role = "hub" }, app = { use_remote_gateways = true } }`,
+			topology: "hub_spoke",
+			error:    `(?s)Call to function "provider::iactools::network_peering_matrix" failed.*spoke\s+"app"\s+sets\s+use_remote_gateways\s+but\s+its\s+hub\s+"hub"\s+has\s+no\s+gateway`,
+		},
+		"use-remote-gateways-with-own-gateway": {
+			networks: `{ hub = { role = "hub", gateway = true }, app = { gateway = true, use_remote_gateways = true } }`,
+			topology: "hub_spoke",
+			error:    `(?s)Call to function "provider::iactools::network_peering_matrix" failed.*spoke\s+"app"\s+sets\s+use_remote_gateways\s+but\s+has\s+a\s+gateway\s+of\s+its\s+own`,
+		},
+		"spoke-without-hub": {
+			networks: `{ hub-weu = { role = "hub", region = "westeurope" }, hub-neu = { role = "hub", region = "northeurope" }, app = { region = "eastus" } }`,
+			topology: "multi_hub",
+			error:    `(?s)Call to function "provider::iactools::network_peering_matrix" failed.*spoke\s+"app"\s+has\s+no\s+hub\s+in\s+region\s+"eastus"`,
+		},
+		"hub-is-not-a-hub": {
+			networks: `{ hub = { role = "hub" }, app = { hub = "data" }, data = {} }`,
+			topology: "hub_spoke",
+			error:    `(?s)Call to function "provider::iactools::network_peering_matrix" failed.*hub\s+"data"\s+of\s+spoke\s+"app"\s+is\s+not\s+a\s+hub\s+network`,
+		},
+		"invalid-role": {
+			networks: `{ a = { role = "transit" } }`,
+			topology: "full_mesh",
+			error:    `(?s)Call to function "provider::iactools::network_peering_matrix" failed.*invalid\s+role\s+"transit"`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::network_peering_matrix(%s, %q)
+							}
+						`, testCase.networks, testCase.topology),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
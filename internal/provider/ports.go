@@ -0,0 +1,214 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Port number limits.
+const (
+	portMin = 0
+	portMax = 65535
+)
+
+// allPorts is the port range set of every port.
+var allPorts = []IntRange{{From: portMin, To: portMax}}
+
+// portProtocols are the protocols accepted as port prefixes like "tcp/443".
+var portProtocols = []string{"sctp", "tcp", "udp"}
+
+// Output formats of the port functions.
+const (
+	portFormatString  = "string"
+	portFormatList    = "list"
+	portFormatObjects = "objects"
+)
+
+// PortSet holds normalized port ranges per protocol, ports without a protocol prefix are stored under the empty protocol.
+type PortSet map[string][]IntRange
+
+// PortRangeEntry is a port range with its optional protocol.
+type PortRangeEntry struct {
+	Protocol *string `tfsdk:"protocol"`
+	From     int64   `tfsdk:"from"`
+	To       int64   `tfsdk:"to"`
+}
+
+// ParsePortSet parses port lists like "80,443,8000-8100" into a port set.
+// Every element can have a protocol prefix like "tcp/443", ports without a prefix form their own set
+// and are not combined with the ports of a protocol.
+func ParsePortSet(specs ...string) (PortSet, error) {
+	ranges := make(map[string][]IntRange)
+	for _, spec := range specs {
+		for _, part := range strings.Split(spec, ",") {
+			part = strings.TrimSpace(part)
+			if part == "" {
+				continue
+			}
+
+			var protocol string
+			if prefix, value, ok := strings.Cut(part, "/"); ok {
+				protocol = strings.ToLower(strings.TrimSpace(prefix))
+				if !slices.Contains(portProtocols, protocol) {
+					return nil, fmt.Errorf("invalid port range %q: protocol must be one of %s", part, strings.Join(portProtocols, ", "))
+				}
+				part = value
+			}
+
+			parsed, err := parsePortRanges(part)
+			if err != nil {
+				return nil, err
+			}
+			ranges[protocol] = append(ranges[protocol], parsed...)
+		}
+	}
+	if len(ranges) == 0 {
+		return nil, fmt.Errorf("port list %q is empty", strings.Join(specs, ","))
+	}
+
+	set := make(PortSet, len(ranges))
+	for protocol, protocolRanges := range ranges {
+		set[protocol] = normalizeIntRanges(protocolRanges)
+	}
+	return set, nil
+}
+
+// PortsMerge returns the union of the port sets.
+func PortsMerge(sets ...PortSet) PortSet {
+	ranges := make(map[string][]IntRange)
+	for _, set := range sets {
+		for protocol, protocolRanges := range set {
+			ranges[protocol] = append(ranges[protocol], protocolRanges...)
+		}
+	}
+
+	merged := make(PortSet, len(ranges))
+	for protocol, protocolRanges := range ranges {
+		merged[protocol] = normalizeIntRanges(protocolRanges)
+	}
+	return merged
+}
+
+// PortsSubtract returns the ports of the set that are not in the removed set.
+func PortsSubtract(set, remove PortSet) PortSet {
+	result := make(PortSet, len(set))
+	for protocol, protocolRanges := range set {
+		if remaining := subtractIntRanges(protocolRanges, remove[protocol]); len(remaining) > 0 {
+			result[protocol] = remaining
+		}
+	}
+	return result
+}
+
+// PortsContains reports whether the set contains every port of the candidate set.
+func PortsContains(set, candidate PortSet) bool {
+	for protocol, protocolRanges := range candidate {
+		if !intRangesContain(set[protocol], protocolRanges) {
+			return false
+		}
+	}
+	return true
+}
+
+// Strings returns the canonical port ranges of the set, ordered by protocol and port, with their protocol prefixes.
+func (s PortSet) Strings() []string {
+	result := make([]string, 0)
+	for _, protocol := range s.protocols() {
+		for _, r := range s[protocol] {
+			value := strconv.FormatInt(r.From, 10)
+			if r.To != r.From {
+				value += "-" + strconv.FormatInt(r.To, 10)
+			}
+			if protocol != "" {
+				value = protocol + "/" + value
+			}
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+// String returns the canonical comma separated form of the set.
+func (s PortSet) String() string {
+	return strings.Join(s.Strings(), ",")
+}
+
+// Entries returns the port ranges of the set as objects, ordered by protocol and port.
+func (s PortSet) Entries() []PortRangeEntry {
+	result := make([]PortRangeEntry, 0)
+	for _, protocol := range s.protocols() {
+		for _, r := range s[protocol] {
+			entry := PortRangeEntry{From: r.From, To: r.To}
+			if protocol != "" {
+				entry.Protocol = &protocol
+			}
+			result = append(result, entry)
+		}
+	}
+	return result
+}
+
+// parsePortRanges parses a comma separated list of ports and port ranges like "80,443,8000-8100".
+// The wildcards "*", "any" and "all" stand for every port.
+func parsePortRanges(spec string) ([]IntRange, error) {
+	var ranges []IntRange
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		switch strings.ToLower(part) {
+		case "*", "any", "all":
+			ranges = append(ranges, allPorts...)
+			continue
+		}
+
+		from, to, isRange := strings.Cut(part, "-")
+		first, err := parsePort(from)
+		if err != nil {
+			return nil, fmt.Errorf("invalid port range %q: %v", part, err)
+		}
+		last := first
+		if isRange {
+			if last, err = parsePort(to); err != nil {
+				return nil, fmt.Errorf("invalid port range %q: %v", part, err)
+			}
+		}
+		if first > last {
+			return nil, fmt.Errorf("invalid port range %q: start must not be greater than end", part)
+		}
+		ranges = append(ranges, IntRange{From: first, To: last})
+	}
+	if len(ranges) == 0 {
+		return nil, fmt.Errorf("port list %q is empty", spec)
+	}
+	return normalizeIntRanges(ranges), nil
+}
+
+// Helper functions
+
+// protocols returns the sorted protocols of the set, ports without a protocol come first.
+func (s PortSet) protocols() []string {
+	protocols := make([]string, 0, len(s))
+	for protocol := range s {
+		protocols = append(protocols, protocol)
+	}
+	sort.Strings(protocols)
+	return protocols
+}
+
+// parsePort parses a single port number.
+func parsePort(value string) (int64, error) {
+	port, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+	if err != nil || port < portMin || port > portMax {
+		return 0, fmt.Errorf("ports must be integers between %d and %d", portMin, portMax)
+	}
+	return port, nil
+}
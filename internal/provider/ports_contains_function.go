@@ -0,0 +1,85 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = PortsContainsFunction{}
+)
+
+// NewPortsContainsFunction is a helper function to create a new instance of PortsContainsFunction.
+func NewPortsContainsFunction() function.Function {
+	return PortsContainsFunction{}
+}
+
+// PortsContainsFunction is the struct for the ports contains function.
+type PortsContainsFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f PortsContainsFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ports_contains"
+}
+
+// Definition sets the definition for the function.
+func (f PortsContainsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Check whether a port list contains other ports",
+		MarkdownDescription: "Returns true when every port of the candidate port list is in the port list, comparing ports with the same protocol prefix.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "ports",
+				MarkdownDescription: "The port list to search in",
+			},
+			function.StringParameter{
+				Name:                "candidate",
+				MarkdownDescription: "The ports to look for, e.g. `\"443\"` or `\"tcp/8000-8010\"`",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+// Run executes the ports contains function.
+func (f PortsContainsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ports, candidate string
+
+	// Parse the arguments
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &ports, &candidate))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input arguments
+	if ports == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The ports argument must be provided and valid"))
+		return
+	}
+	if candidate == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The candidate argument must be provided and valid"))
+		return
+	}
+
+	// Compare the ports
+	set, err := ParsePortSet(ports)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error comparing ports: %s", err.Error())))
+		return
+	}
+	candidateSet, err := ParsePortSet(candidate)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error comparing ports: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, PortsContains(set, candidateSet)))
+}
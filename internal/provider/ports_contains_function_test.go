@@ -0,0 +1,94 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestPortsContainsFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		result    string
+	}{
+		"contained": {
+			arguments: `"80,443,8000-8100", "443,8050-8060"`,
+			result:    `true`,
+		},
+		"not-contained": {
+			arguments: `"80,443,8000-8100", "8090-8110"`,
+			result:    `false`,
+		},
+		"different-protocol": {
+			arguments: `"tcp/443", "udp/443"`,
+			result:    `false`,
+		},
+		"wildcard": {
+			arguments: `"*", "1-65535"`,
+			result:    `true`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::ports_contains(%s))
+							}
+						`, testCase.arguments),
+						Check: resource.TestCheckOutput("result", testCase.result),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestPortsContainsFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		error     string
+	}{
+		"empty-candidate": {
+			arguments: `"80", ""`,
+			error:     `(?s)Call to function "provider::iactools::ports_contains" failed.*candidate\s+argument\s+must.*be\s+provided\s+and\s+valid`,
+		},
+		"invalid-ports": {
+			arguments: `"80,,abc", "80"`,
+			error:     `(?s)Call to function "provider::iactools::ports_contains" failed.*invalid\s+port\s+range\s+"abc"`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::ports_contains(%s)
+							}
+						`, testCase.arguments),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = PortsMergeFunction{}
+)
+
+// NewPortsMergeFunction is a helper function to create a new instance of PortsMergeFunction.
+func NewPortsMergeFunction() function.Function {
+	return PortsMergeFunction{}
+}
+
+// PortsMergeFunction is the struct for the ports merge function.
+type PortsMergeFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f PortsMergeFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ports_merge"
+}
+
+// Definition sets the definition for the function.
+func (f PortsMergeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Merge port lists into their canonical minimal form",
+		MarkdownDescription: "Outputs the union of port lists like `\"80,443\"` and `\"tcp/8000-8100\"`, with the overlapping and adjacent ranges merged. " +
+			"Ports with the same protocol prefix are merged together, ports without a prefix form their own set.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "ports",
+				MarkdownDescription: "The port lists to merge",
+				ElementType:         types.StringType,
+			},
+			portFormatParameter,
+		},
+		Return: function.DynamicReturn{},
+	}
+}
+
+// Run executes the ports merge function.
+func (f PortsMergeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ports []string
+	var format *string
+
+	// Parse the arguments
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &ports, &format))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input arguments
+	if len(ports) == 0 {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The ports argument must be provided and valid"))
+		return
+	}
+
+	// Merge the ports
+	set, err := ParsePortSet(ports...)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error merging ports: %s", err.Error())))
+		return
+	}
+	result, err := portSetValue(set, stringValueOrEmpty(format))
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error merging ports: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.DynamicValue(result)))
+}
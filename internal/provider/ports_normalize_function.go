@@ -0,0 +1,126 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = PortsNormalizeFunction{}
+)
+
+// portRangeEntryType is the object type of a port range in the objects output format.
+var portRangeEntryType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"protocol": types.StringType,
+		"from":     types.Int64Type,
+		"to":       types.Int64Type,
+	},
+}
+
+// portFormatParameter is the format parameter shared by the port functions.
+var portFormatParameter = function.StringParameter{
+	Name: "format",
+	MarkdownDescription: "The output format: `string` for a comma separated string like `\"80,443,8000-8100\"` (the default), " +
+		"`list` for a list of strings, or `objects` for a list of objects with `protocol`, `from` and `to` attributes. Can be null.",
+	AllowNullValue: true,
+}
+
+// NewPortsNormalizeFunction is a helper function to create a new instance of PortsNormalizeFunction.
+func NewPortsNormalizeFunction() function.Function {
+	return PortsNormalizeFunction{}
+}
+
+// PortsNormalizeFunction is the struct for the ports normalize function.
+type PortsNormalizeFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f PortsNormalizeFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ports_normalize"
+}
+
+// Definition sets the definition for the function.
+func (f PortsNormalizeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Normalize a port list to its canonical minimal form",
+		MarkdownDescription: "Parses a comma separated list of ports and port ranges like `\"443,80,8000-8100,8050\"`, " +
+			"sorts it and merges the overlapping and adjacent ranges. Elements can have a `tcp/`, `udp/` or `sctp/` protocol prefix, " +
+			"ports without a prefix form their own set. The wildcards `*`, `any` and `all` stand for the ports 0-65535.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "ports",
+				MarkdownDescription: "The port list to normalize",
+			},
+			portFormatParameter,
+		},
+		Return: function.DynamicReturn{},
+	}
+}
+
+// Run executes the ports normalize function.
+func (f PortsNormalizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ports string
+	var format *string
+
+	// Parse the arguments
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &ports, &format))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input arguments
+	if ports == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The ports argument must be provided and valid"))
+		return
+	}
+
+	// Normalize the ports
+	set, err := ParsePortSet(ports)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error normalizing ports: %s", err.Error())))
+		return
+	}
+	result, err := portSetValue(set, stringValueOrEmpty(format))
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error normalizing ports: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.DynamicValue(result)))
+}
+
+// portSetValue converts a port set into the Terraform value of the requested output format.
+func portSetValue(set PortSet, format string) (attr.Value, error) {
+	switch format {
+	case "", portFormatString:
+		return types.StringValue(set.String()), nil
+	case portFormatList:
+		values := make([]attr.Value, 0)
+		for _, value := range set.Strings() {
+			values = append(values, types.StringValue(value))
+		}
+		return types.ListValueMust(types.StringType, values), nil
+	case portFormatObjects:
+		values := make([]attr.Value, 0)
+		for _, entry := range set.Entries() {
+			values = append(values, types.ObjectValueMust(portRangeEntryType.AttrTypes, map[string]attr.Value{
+				"protocol": types.StringPointerValue(entry.Protocol),
+				"from":     types.Int64Value(entry.From),
+				"to":       types.Int64Value(entry.To),
+			}))
+		}
+		return types.ListValueMust(portRangeEntryType, values), nil
+	default:
+		return nil, fmt.Errorf("unknown format %q, must be one of %s, %s or %s", format, portFormatString, portFormatList, portFormatObjects)
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestPortsNormalizeFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		result    string
+	}{
+		"merge-adjacent-and-overlapping": {
+			arguments: `"443,80,8000-8100,8050,81,8101-8200", null`,
+			result:    `"80-81,443,8000-8200"`,
+		},
+		"protocol-prefixes": {
+			arguments: `"udp/53, tcp/443, 53, TCP/80", "string"`,
+			result:    `"53,tcp/80,tcp/443,udp/53"`,
+		},
+		"wildcard": {
+			arguments: `"*,22", null`,
+			result:    `"0-65535"`,
+		},
+		"list-format": {
+			arguments: `"443,80,8000-8100", "list"`,
+			result:    `["80","443","8000-8100"]`,
+		},
+		"objects-format": {
+			arguments: `"tcp/443,80-81", "objects"`,
+			result:    `[{"from":80,"protocol":null,"to":81},{"from":443,"protocol":"tcp","to":443}]`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::ports_normalize(%s))
+							}
+						`, testCase.arguments),
+						Check: resource.TestCheckOutput("result", testCase.result),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestPortsNormalizeFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		error     string
+	}{
+		"empty-ports": {
+			arguments: `"", null`,
+			error:     `(?s)Call to function "provider::iactools::ports_normalize" failed.*ports\s+argument\s+must\s+be.*provided\s+and\s+valid`,
+		},
+		"out-of-range": {
+			arguments: `"80,65536", null`,
+			error:     `(?s)Call to function "provider::iactools::ports_normalize" failed.*invalid\s+port\s+range.*"65536"`,
+		},
+		"reversed-range": {
+			arguments: `"8100-8000", null`,
+			error:     `(?s)Call to function "provider::iactools::ports_normalize" failed.*start\s+must\s+not\s+be\s+greater\s+than\s+end`,
+		},
+		"unknown-protocol": {
+			arguments: `"icmp/8", null`,
+			error:     `(?s)Call to function "provider::iactools::ports_normalize" failed.*protocol\s+must\s+be\s+one\s+of\s+sctp,\s+tcp,\s+udp`,
+		},
+		"unknown-format": {
+			arguments: `"80", "csv"`,
+			error:     `(?s)Call to function "provider::iactools::ports_normalize" failed.*unknown\s+format\s+"csv"`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::ports_normalize(%s)
+							}
+						`, testCase.arguments),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
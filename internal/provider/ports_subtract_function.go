@@ -0,0 +1,95 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = PortsSubtractFunction{}
+)
+
+// NewPortsSubtractFunction is a helper function to create a new instance of PortsSubtractFunction.
+func NewPortsSubtractFunction() function.Function {
+	return PortsSubtractFunction{}
+}
+
+// PortsSubtractFunction is the struct for the ports subtract function.
+type PortsSubtractFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f PortsSubtractFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ports_subtract"
+}
+
+// Definition sets the definition for the function.
+func (f PortsSubtractFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Remove ports from a port list",
+		MarkdownDescription: "Outputs the ports of a port list that are not in another port list, in canonical minimal form. " +
+			"Use `*` as the port list to express \"every port except\" rules, e.g. `\"*\"` minus `\"22,3389\"` results in `\"0-21,23-3388,3390-65535\"`. " +
+			"Only ports with the same protocol prefix are removed. The result is empty when no port remains.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "ports",
+				MarkdownDescription: "The port list to remove ports from",
+			},
+			function.StringParameter{
+				Name:                "remove",
+				MarkdownDescription: "The port list to remove",
+			},
+			portFormatParameter,
+		},
+		Return: function.DynamicReturn{},
+	}
+}
+
+// Run executes the ports subtract function.
+func (f PortsSubtractFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ports, remove string
+	var format *string
+
+	// Parse the arguments
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &ports, &remove, &format))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input arguments
+	if ports == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The ports argument must be provided and valid"))
+		return
+	}
+	if remove == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The remove argument must be provided and valid"))
+		return
+	}
+
+	// Subtract the ports
+	set, err := ParsePortSet(ports)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error subtracting ports: %s", err.Error())))
+		return
+	}
+	removed, err := ParsePortSet(remove)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error subtracting ports: %s", err.Error())))
+		return
+	}
+	result, err := portSetValue(PortsSubtract(set, removed), stringValueOrEmpty(format))
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error subtracting ports: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.DynamicValue(result)))
+}
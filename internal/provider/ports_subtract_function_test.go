@@ -0,0 +1,94 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestPortsSubtractFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		result    string
+	}{
+		"everything-except": {
+			arguments: `"*", "22,3389", null`,
+			result:    `"0-21,23-3388,3390-65535"`,
+		},
+		"split-range": {
+			arguments: `"8000-8100", "8050", "objects"`,
+			result:    `[{"from":8000,"protocol":null,"to":8049},{"from":8051,"protocol":null,"to":8100}]`,
+		},
+		"same-protocol-only": {
+			arguments: `"tcp/80,udp/80", "tcp/80", null`,
+			result:    `"udp/80"`,
+		},
+		"nothing-left": {
+			arguments: `"80,443", "1-1024", "list"`,
+			result:    `[]`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::ports_subtract(%s))
+							}
+						`, testCase.arguments),
+						Check: resource.TestCheckOutput("result", testCase.result),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestPortsSubtractFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		error     string
+	}{
+		"empty-remove": {
+			arguments: `"80", "", null`,
+			error:     `(?s)Call to function "provider::iactools::ports_subtract" failed.*remove\s+argument\s+must\s+be.*provided\s+and\s+valid`,
+		},
+		"invalid-remove": {
+			arguments: `"80", "22-", null`,
+			error:     `(?s)Call to function "provider::iactools::ports_subtract" failed.*invalid\s+port\s+range\s+"22-"`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::ports_subtract(%s)
+							}
+						`, testCase.arguments),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
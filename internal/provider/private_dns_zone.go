@@ -0,0 +1,220 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// privateDNSZonesJSON is the embedded dataset of the private DNS zones of cloud PaaS private endpoints.
+//
+//go:embed data/private_dns_zones.json
+var privateDNSZonesJSON []byte
+
+// Placeholders in the zone names of regional services.
+const (
+	// privateDNSZoneRegionPlaceholder is replaced with the region, e.g. westeurope.
+	privateDNSZoneRegionPlaceholder = "{region}"
+
+	// privateDNSZoneGeoCodePlaceholder is replaced with the geo code of the region, e.g. we for westeurope, used by Azure Backup.
+	privateDNSZoneGeoCodePlaceholder = "{geo_code}"
+)
+
+// privateDNSZoneDataset is the structure of the embedded private DNS zone dataset.
+type privateDNSZoneDataset struct {
+	Version string                         `json:"version"`
+	Clouds  map[string]privateDNSZoneCloud `json:"clouds"`
+}
+
+// privateDNSZoneCloud holds the environments, region geo codes and services of a cloud.
+type privateDNSZoneCloud struct {
+	Environments []string                `json:"environments"`
+	GeoCodes     map[string]string       `json:"geo_codes"`
+	Services     []privateDNSZoneService `json:"services"`
+}
+
+// privateDNSZoneService holds the zone names of a service subresource per environment.
+type privateDNSZoneService struct {
+	Service     string              `json:"service"`
+	Subresource string              `json:"subresource"`
+	Zones       map[string][]string `json:"zones"`
+}
+
+// PrivateDNSZoneEntry holds the resolved zone names of a service subresource.
+type PrivateDNSZoneEntry struct {
+	Service     string   `tfsdk:"service"`
+	Subresource string   `tfsdk:"subresource"`
+	Zones       []string `tfsdk:"zones"`
+}
+
+// loadPrivateDNSZoneDataset parses the embedded dataset once.
+var loadPrivateDNSZoneDataset = sync.OnceValues(func() (*privateDNSZoneDataset, error) {
+	var dataset privateDNSZoneDataset
+	if err := json.Unmarshal(privateDNSZonesJSON, &dataset); err != nil {
+		return nil, fmt.Errorf("cannot parse the private DNS zone dataset: %v", err)
+	}
+	return &dataset, nil
+})
+
+// PrivateDNSZoneDatasetVersion returns the version of the embedded private DNS zone dataset.
+func PrivateDNSZoneDatasetVersion() (string, error) {
+	dataset, err := loadPrivateDNSZoneDataset()
+	if err != nil {
+		return "", err
+	}
+	return dataset.Version, nil
+}
+
+// PrivateDNSZone returns the private DNS zone names of a service subresource in a cloud environment.
+func PrivateDNSZone(cloud, environment, service, subresource, region string) ([]string, error) {
+	entry, environment, err := privateDNSZoneServices(cloud, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []privateDNSZoneService
+	var subresources []string
+	for _, candidate := range entry.Services {
+		if !strings.EqualFold(candidate.Service, service) {
+			continue
+		}
+		subresources = append(subresources, candidate.Subresource)
+		if subresource == "" || strings.EqualFold(candidate.Subresource, subresource) {
+			matches = append(matches, candidate)
+		}
+	}
+
+	if len(subresources) == 0 {
+		return nil, fmt.Errorf("unknown %s service %q", cloud, service)
+	}
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("unknown subresource %q for service %q, available subresources: %s", subresource, service, strings.Join(subresources, ", "))
+	}
+	if len(matches) > 1 {
+		return nil, fmt.Errorf("service %q has multiple subresources, one of %s must be provided", service, strings.Join(subresources, ", "))
+	}
+
+	zones, ok := matches[0].Zones[environment]
+	if !ok {
+		return nil, fmt.Errorf("service %q with subresource %q is not available in the %s environment", service, matches[0].Subresource, environment)
+	}
+
+	resolved := make([]string, 0, len(zones))
+	for _, zone := range zones {
+		if isRegionalPrivateDNSZone(zone) && region == "" {
+			return nil, fmt.Errorf("service %q with subresource %q uses regional zones, the region must be provided", service, matches[0].Subresource)
+		}
+		zone, err := resolvePrivateDNSZone(entry, zone, region)
+		if err != nil {
+			return nil, err
+		}
+		resolved = append(resolved, zone)
+	}
+	return resolved, nil
+}
+
+// PrivateDNSZones returns the private DNS zone names of every service in a cloud environment.
+// Regional zones are only included when the region is provided.
+func PrivateDNSZones(cloud, environment, region string) ([]PrivateDNSZoneEntry, error) {
+	entry, environment, err := privateDNSZoneServices(cloud, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]PrivateDNSZoneEntry, 0, len(entry.Services))
+	for _, service := range entry.Services {
+		var zones []string
+		for _, zone := range service.Zones[environment] {
+			if isRegionalPrivateDNSZone(zone) && region == "" {
+				continue
+			}
+			zone, err := resolvePrivateDNSZone(entry, zone, region)
+			if err != nil {
+				return nil, err
+			}
+			zones = append(zones, zone)
+		}
+		if len(zones) == 0 {
+			continue
+		}
+		entries = append(entries, PrivateDNSZoneEntry{
+			Service:     service.Service,
+			Subresource: service.Subresource,
+			Zones:       zones,
+		})
+	}
+	return entries, nil
+}
+
+// Helper functions
+
+// privateDNSZoneServices looks up a cloud and returns it with the canonical environment name.
+func privateDNSZoneServices(cloud, environment string) (privateDNSZoneCloud, string, error) {
+	dataset, err := loadPrivateDNSZoneDataset()
+	if err != nil {
+		return privateDNSZoneCloud{}, "", err
+	}
+
+	entry, ok := dataset.Clouds[strings.ToLower(cloud)]
+	if !ok {
+		clouds := make([]string, 0, len(dataset.Clouds))
+		for name := range dataset.Clouds {
+			clouds = append(clouds, name)
+		}
+		sort.Strings(clouds)
+		return privateDNSZoneCloud{}, "", fmt.Errorf("unknown cloud %q, available clouds: %s", cloud, strings.Join(clouds, ", "))
+	}
+
+	for _, name := range entry.Environments {
+		if strings.EqualFold(name, environment) {
+			return entry, name, nil
+		}
+	}
+	return privateDNSZoneCloud{}, "", fmt.Errorf("unknown %s environment %q, available environments: %s", cloud, environment, strings.Join(entry.Environments, ", "))
+}
+
+// isRegionalPrivateDNSZone reports whether a zone name has a region or geo code placeholder.
+func isRegionalPrivateDNSZone(zone string) bool {
+	return strings.Contains(zone, privateDNSZoneRegionPlaceholder) || strings.Contains(zone, privateDNSZoneGeoCodePlaceholder)
+}
+
+// resolvePrivateDNSZone replaces the region and geo code placeholders of a zone name.
+func resolvePrivateDNSZone(cloud privateDNSZoneCloud, zone, region string) (string, error) {
+	region = normalizePrivateDNSZoneRegion(region)
+	if strings.Contains(zone, privateDNSZoneGeoCodePlaceholder) {
+		geoCode, ok := cloud.GeoCodes[region]
+		if !ok {
+			return "", fmt.Errorf("the zone %s needs the geo code of the region, no geo code is known for the region %q", zone, region)
+		}
+		zone = strings.ReplaceAll(zone, privateDNSZoneGeoCodePlaceholder, geoCode)
+	}
+	return strings.ReplaceAll(zone, privateDNSZoneRegionPlaceholder, region), nil
+}
+
+// normalizePrivateDNSZoneRegion converts a region display name like "West Europe" to its DNS form.
+func normalizePrivateDNSZoneRegion(region string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(region), " ", ""))
+}
+
+// uniqueZoneNames returns the sorted distinct zone names of the entries.
+func uniqueZoneNames(entries []PrivateDNSZoneEntry) []string {
+	seen := make(map[string]bool)
+	names := make([]string, 0)
+	for _, entry := range entries {
+		for _, zone := range entry.Zones {
+			if !seen[zone] {
+				seen[zone] = true
+				names = append(names, zone)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
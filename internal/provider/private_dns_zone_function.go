@@ -0,0 +1,112 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = PrivateDNSZoneFunction{}
+)
+
+// NewPrivateDNSZoneFunction is a helper function to create a new instance of PrivateDNSZoneFunction.
+func NewPrivateDNSZoneFunction() function.Function {
+	return PrivateDNSZoneFunction{}
+}
+
+// PrivateDNSZoneFunction is the struct for the private DNS zone function.
+type PrivateDNSZoneFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f PrivateDNSZoneFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "private_dns_zone"
+}
+
+// Definition sets the definition for the function.
+func (f PrivateDNSZoneFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Look up the private DNS zones of a cloud PaaS private endpoint",
+		MarkdownDescription: "Outputs the private DNS zone names required by a private endpoint of an Azure, AWS or GCP service, " +
+			"based on the dataset embedded in the provider. Use the `iactools_private_dns_zones` data source to list every zone of a cloud environment.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cloud",
+				MarkdownDescription: "The cloud platform: `azure`, `aws` or `gcp`",
+			},
+			function.StringParameter{
+				Name:                "environment",
+				MarkdownDescription: "The cloud environment: `public`, `usgovernment` or `china` for Azure, `commercial`, `govcloud` or `china` for AWS and `public` for GCP",
+			},
+			function.StringParameter{
+				Name:                "service",
+				MarkdownDescription: "The service, e.g. the Azure resource type `Microsoft.Storage/storageAccounts` or the AWS endpoint service `ecr.api`",
+			},
+			function.StringParameter{
+				Name:                "subresource",
+				MarkdownDescription: "The subresource or group ID of the private endpoint, e.g. `blob`, can be null when the service has a single subresource",
+				AllowNullValue:      true,
+			},
+			function.StringParameter{
+				Name: "region",
+				MarkdownDescription: "The region of the service, required by regional zones like the ones of AKS or AWS endpoints. " +
+					"Azure Backup zones use the geo code of the region instead, e.g. `we` for `westeurope`, which is looked up in the dataset",
+				AllowNullValue: true,
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+// Run executes the private DNS zone function.
+func (f PrivateDNSZoneFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cloud, environment, service string
+	var subresource, region *string
+
+	// Parse the arguments
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &cloud, &environment, &service, &subresource, &region))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input arguments
+	if cloud == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The cloud argument must be provided and valid"))
+		return
+	}
+	if environment == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The environment argument must be provided and valid"))
+		return
+	}
+	if service == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The service argument must be provided and valid"))
+		return
+	}
+
+	// Look up the private DNS zones
+	zones, err := PrivateDNSZone(cloud, environment, service, stringValueOrEmpty(subresource), stringValueOrEmpty(region))
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error looking up private DNS zones: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, zones))
+}
+
+// stringValueOrEmpty dereferences an optional string argument.
+func stringValueOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
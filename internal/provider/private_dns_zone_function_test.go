@@ -0,0 +1,142 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestPrivateDNSZoneFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		zones     string
+	}{
+		"azure-storage-blob": {
+			arguments: `"azure", "public", "Microsoft.Storage/storageAccounts", "blob", null`,
+			zones:     `["privatelink.blob.core.windows.net"]`,
+		},
+		"azure-case-insensitive": {
+			arguments: `"Azure", "Public", "microsoft.keyvault/vaults", "Vault", null`,
+			zones:     `["privatelink.vaultcore.azure.net"]`,
+		},
+		"azure-sovereign-cloud": {
+			arguments: `"azure", "usgovernment", "Microsoft.Storage/storageAccounts", "file", null`,
+			zones:     `["privatelink.file.core.usgovcloudapi.net"]`,
+		},
+		"azure-single-subresource": {
+			arguments: `"azure", "china", "Microsoft.Sql/servers", null, null`,
+			zones:     `["privatelink.database.chinacloudapi.cn"]`,
+		},
+		"azure-regional": {
+			arguments: `"azure", "public", "Microsoft.ContainerService/managedClusters", "management", "West Europe"`,
+			zones:     `["privatelink.westeurope.azmk8s.io"]`,
+		},
+		"azure-multiple-zones": {
+			arguments: `"azure", "public", "Microsoft.ContainerRegistry/registries", "registry", "northeurope"`,
+			zones:     `["privatelink.azurecr.io","northeurope.data.privatelink.azurecr.io"]`,
+		},
+		"azure-backup-geo-code": {
+			arguments: `"azure", "public", "Microsoft.RecoveryServices/vaults", "AzureBackup", "West Europe"`,
+			zones:     `["privatelink.we.backup.windowsazure.com"]`,
+		},
+		"aws-china": {
+			arguments: `"aws", "china", "ecr.api", null, "cn-north-1"`,
+			zones:     `["api.ecr.cn-north-1.amazonaws.com.cn"]`,
+		},
+		"gcp-googleapis": {
+			arguments: `"gcp", "public", "googleapis", "all-apis", null`,
+			zones:     `["googleapis.com"]`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::private_dns_zone(%s))
+							}
+						`, testCase.arguments),
+						Check: resource.TestCheckOutput("result", testCase.zones),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestPrivateDNSZoneFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		error     string
+	}{
+		"empty-cloud": {
+			arguments: `"", "public", "Microsoft.Sql/servers", null, null`,
+			error:     `(?s)Call to function "provider::iactools::private_dns_zone" failed.*The cloud.*argument must be provided and valid`,
+		},
+		"unknown-cloud": {
+			arguments: `"oracle", "public", "Microsoft.Sql/servers", null, null`,
+			error:     `(?s)Call to function "provider::iactools::private_dns_zone" failed.*unknown cloud.*"oracle"`,
+		},
+		"unknown-environment": {
+			arguments: `"azure", "germany", "Microsoft.Sql/servers", null, null`,
+			error:     `(?s)Call to function "provider::iactools::private_dns_zone" failed.*unknown azure.*environment "germany"`,
+		},
+		"unknown-service": {
+			arguments: `"azure", "public", "Microsoft.Foo/bars", null, null`,
+			error:     `(?s)Call to function "provider::iactools::private_dns_zone" failed.*unknown azure.*service.*"Microsoft.Foo/bars"`,
+		},
+		"ambiguous-subresource": {
+			arguments: `"azure", "public", "Microsoft.Storage/storageAccounts", null, null`,
+			error:     `(?s)Call to function "provider::iactools::private_dns_zone" failed.*has\s+multiple\s+subresources`,
+		},
+		"unknown-subresource": {
+			arguments: `"azure", "public", "Microsoft.Storage/storageAccounts", "sql", null`,
+			error:     `(?s)Call to function "provider::iactools::private_dns_zone" failed.*unknown.*subresource "sql"`,
+		},
+		"missing-region": {
+			arguments: `"azure", "public", "Microsoft.ContainerService/managedClusters", "management", null`,
+			error:     `(?s)Call to function "provider::iactools::private_dns_zone" failed.*uses\s+regional\s+zones,\s+the\s+region\s+must\s+be\s+provided`,
+		},
+		"unknown-geo-code": {
+			arguments: `"azure", "public", "Microsoft.RecoveryServices/vaults", "AzureBackup", "atlantis"`,
+			error:     `(?s)Call to function "provider::iactools::private_dns_zone" failed.*no\s+geo\s+code\s+is\s+known\s+for\s+the\s+region\s+"atlantis"`,
+		},
+		"unavailable-in-environment": {
+			arguments: `"azure", "china", "Microsoft.Dashboard/grafana", null, null`,
+			error:     `(?s)Call to function "provider::iactools::private_dns_zone" failed.*is not available in the.*china environment`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::private_dns_zone(%s)
+							}
+						`, testCase.arguments),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
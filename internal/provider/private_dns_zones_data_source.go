@@ -0,0 +1,122 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &PrivateDNSZonesDataSource{}
+)
+
+// NewPrivateDNSZonesDataSource is a helper function to create a new instance of PrivateDNSZonesDataSource.
+func NewPrivateDNSZonesDataSource() datasource.DataSource {
+	return &PrivateDNSZonesDataSource{}
+}
+
+// PrivateDNSZonesDataSource is the struct for the private DNS zones data source.
+type PrivateDNSZonesDataSource struct{}
+
+// privateDNSZonesDataSourceModel describes the private DNS zones data source data model.
+type privateDNSZonesDataSourceModel struct {
+	Cloud          types.String          `tfsdk:"cloud"`
+	Environment    types.String          `tfsdk:"environment"`
+	Region         types.String          `tfsdk:"region"`
+	DatasetVersion types.String          `tfsdk:"dataset_version"`
+	Services       []PrivateDNSZoneEntry `tfsdk:"services"`
+	ZoneNames      []string              `tfsdk:"zone_names"`
+}
+
+// Metadata sets the metadata for the data source.
+func (d *PrivateDNSZonesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_private_dns_zones"
+}
+
+// Schema sets the schema for the data source.
+func (d *PrivateDNSZonesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the private DNS zones of every cloud PaaS private endpoint service in a cloud environment, " +
+			"based on the dataset embedded in the provider. Use the `private_dns_zone` function to look up the zones of a single service.",
+		Attributes: map[string]schema.Attribute{
+			"cloud": schema.StringAttribute{
+				MarkdownDescription: "The cloud platform: `azure`, `aws` or `gcp`",
+				Required:            true,
+			},
+			"environment": schema.StringAttribute{
+				MarkdownDescription: "The cloud environment: `public`, `usgovernment` or `china` for Azure, `commercial`, `govcloud` or `china` for AWS and `public` for GCP",
+				Required:            true,
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The region used in the names of regional zones, regional zones are omitted when it is not set. " +
+					"Azure Backup zones use the geo code of the region, e.g. `we` for `westeurope`",
+				Optional: true,
+			},
+			"dataset_version": schema.StringAttribute{
+				MarkdownDescription: "The version of the embedded private DNS zone dataset",
+				Computed:            true,
+			},
+			"services": schema.ListNestedAttribute{
+				MarkdownDescription: "The private DNS zones per service and subresource",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"service": schema.StringAttribute{
+							MarkdownDescription: "The service, e.g. the Azure resource type or the AWS endpoint service",
+							Computed:            true,
+						},
+						"subresource": schema.StringAttribute{
+							MarkdownDescription: "The subresource or group ID of the private endpoint",
+							Computed:            true,
+						},
+						"zones": schema.ListAttribute{
+							MarkdownDescription: "The private DNS zone names",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"zone_names": schema.ListAttribute{
+				MarkdownDescription: "The sorted distinct private DNS zone names of every service",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Read reads the private DNS zones from the embedded dataset.
+func (d *PrivateDNSZonesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data privateDNSZonesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	version, err := PrivateDNSZoneDatasetVersion()
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading private DNS zones", err.Error())
+		return
+	}
+
+	entries, err := PrivateDNSZones(data.Cloud.ValueString(), data.Environment.ValueString(), data.Region.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading private DNS zones", err.Error())
+		return
+	}
+
+	data.DatasetVersion = types.StringValue(version)
+	data.Services = entries
+	data.ZoneNames = uniqueZoneNames(entries)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,89 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestPrivateDNSZonesDataSource(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "iactools_private_dns_zones" "test" {
+						cloud       = "azure"
+						environment = "public"
+					}
+
+					output "storage_blob" {
+						value = one([for service in data.iactools_private_dns_zones.test.services : service.zones if service.service == "Microsoft.Storage/storageAccounts" && service.subresource == "blob"])[0]
+					}
+
+					output "regional_zones" {
+						value = length([for zone in data.iactools_private_dns_zones.test.zone_names : zone if strcontains(zone, "azmk8s.io")])
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.iactools_private_dns_zones.test", "dataset_version"),
+					resource.TestCheckOutput("storage_blob", "privatelink.blob.core.windows.net"),
+					resource.TestCheckOutput("regional_zones", "0"),
+				),
+			},
+			{
+				Config: `
+					data "iactools_private_dns_zones" "test" {
+						cloud       = "azure"
+						environment = "public"
+						region      = "westeurope"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("data.iactools_private_dns_zones.test", "zone_names.*", "privatelink.westeurope.azmk8s.io"),
+					resource.TestCheckTypeSetElemAttr("data.iactools_private_dns_zones.test", "zone_names.*", "privatelink.vaultcore.azure.net"),
+					resource.TestCheckTypeSetElemAttr("data.iactools_private_dns_zones.test", "zone_names.*", "privatelink.we.backup.windowsazure.com"),
+				),
+			},
+			{
+				Config: `
+					data "iactools_private_dns_zones" "test" {
+						cloud       = "aws"
+						environment = "govcloud"
+						region      = "us-gov-west-1"
+					}
+				`,
+				Check: resource.TestCheckTypeSetElemAttr("data.iactools_private_dns_zones.test", "zone_names.*", "ssm.us-gov-west-1.amazonaws.com"),
+			},
+		},
+	})
+}
+
+func TestPrivateDNSZonesDataSource_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "iactools_private_dns_zones" "test" {
+						cloud       = "azure"
+						environment = "germany"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`(?s)Error reading private DNS zones.*unknown azure environment "germany"`),
+			},
+		},
+	})
+}
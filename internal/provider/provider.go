@@ -78,13 +78,67 @@ func (p *iactoolsProvider) EphemeralResources(ctx context.Context) []func() ephe
 }
 
 func (p *iactoolsProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewPrivateDNSZonesDataSource,
+	}
 }
 
 func (p *iactoolsProvider) Functions(ctx context.Context) []func() function.Function {
 	return []func() function.Function{
 		NewInverseCIDRFunction,
 		NewReverseDNSFunction,
+		NewDMARCBuildFunction,
+		NewDMARCParseFunction,
+		NewDKIMRecordFunction,
+		NewDNSSECDSFunction,
+		NewDNSKEYParseFunction,
+		NewReverseZonePlanFunction,
+		NewPrivateDNSZoneFunction,
+		NewRulePrioritiesFunction,
+		NewSecurityRulesAnalyzeFunction,
+		NewPortsNormalizeFunction,
+		NewPortsMergeFunction,
+		NewPortsSubtractFunction,
+		NewPortsContainsFunction,
+		NewRouteLookupFunction,
+		NewRouteTableEffectiveFunction,
+		NewNetworkPeeringMatrixFunction,
+		NewK8sNetworkPlanFunction,
+		NewIPAddFunction,
+		NewIPDiffFunction,
+		NewIPCompareFunction,
+		NewIPToIntFunction,
+		NewIntToIPFunction,
+		NewIPSortFunction,
+		NewIPv6EUI64Function,
+		NewIPv6EUI64ToMACFunction,
+		NewIPv6LinkLocalFunction,
+		NewMACNormalizeFunction,
+		NewIDAllocateFunction,
+		NewASNParseFunction,
+		NewBGPCommunityParseFunction,
+		NewBGPRouteMapValidateFunction,
+		NewCIDRSpecialPurposeFunction,
+		NewCIDRClassifyFunction,
+		NewCloudIPRangesFunction,
+		NewDualStackMapFunction,
+		NewNAT64EmbedFunction,
+		NewNAT64ExtractFunction,
+		NewDNS64SynthesizeFunction,
+		NewCIDRUtilizationFunction,
+		NewResourceNameFunction,
+		NewResourceNameValidateFunction,
+		NewAzureResourceIDParseFunction,
+		NewAzureResourceIDBuildFunction,
+		NewARNParseFunction,
+		NewARNBuildFunction,
+		NewGCPResourceNameParseFunction,
+		NewStableSuffixFunction,
+		NewNameShortenFunction,
+		NewUUIDV3Function,
+		NewUUIDV5Function,
+		NewUUIDV7Function,
+		NewUUIDParseFunction,
 	}
 }
 
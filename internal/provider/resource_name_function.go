@@ -0,0 +1,148 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = ResourceNameFunction{}
+)
+
+// NewResourceNameFunction is a helper function to create a new instance of ResourceNameFunction.
+func NewResourceNameFunction() function.Function {
+	return ResourceNameFunction{}
+}
+
+// ResourceNameFunction is the struct for the resource name function.
+type ResourceNameFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f ResourceNameFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "resource_name"
+}
+
+// Definition sets the definition for the function.
+func (f ResourceNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Generate a cloud resource name from its components",
+		MarkdownDescription: "Joins the components of a name in the order of the naming convention and applies the rules of the Azure, AWS or GCP resource type " +
+			"from the dataset embedded in the provider: the CAF-style abbreviation, the case, the allowed characters and the maximum length. " +
+			"The `abbreviation` component is the abbreviation of the resource type, e.g. `st` for `azurerm_storage_account`, unless it is set in the components. " +
+			"Separators the resource type does not allow, like hyphens in storage account names, are removed from the name and the components. " +
+			"Names longer than the maximum length are shortened by truncating the longest components and appending a hash of the full name, " +
+			"so the same components always produce the same name. " +
+			"The output also holds the `scope` in which the name must be unique, e.g. `global` for names that are part of a public DNS name, " +
+			"and the function fails with the list of problems when no name can satisfy the rules, " +
+			"including the start and end characters and reserved words checked by `resource_name_validate`. " +
+			"Each problem of the error is followed by the rule that failed, e.g. `max_length`, `characters` or `reserved_prefix`, and its limit, offending characters or reserved word, " +
+			"like `the name is longer than 24 characters (rule max_length, limit 24)`, the same attributes `resource_name_validate` outputs in its `details`. " +
+			"Lengths are counted in characters, and components are truncated by characters, so names with multibyte characters, " +
+			"like resource groups with Unicode letters, are never cut in the middle of a character.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "resource_type",
+				MarkdownDescription: "The Terraform resource type, e.g. `azurerm_key_vault`, `aws_s3_bucket` or `google_storage_bucket`",
+			},
+			function.MapParameter{
+				Name:                "components",
+				ElementType:         types.StringType,
+				MarkdownDescription: "The components of the name by their name in the convention order, e.g. `workload`, `environment`, `region` and `instance`",
+			},
+			function.DynamicParameter{
+				Name: "convention",
+				MarkdownDescription: "An object with the optional attributes `order`, the list of component names defaulting to `[\"abbreviation\", \"workload\", \"environment\", \"region\", \"instance\"]`, " +
+					"`separator`, defaulting to `-`, and `hash_length`, the length of the hash suffix of truncated names defaulting to 4, or null to use the defaults",
+				AllowNullValue: true,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"name":         types.StringType,
+				"abbreviation": types.StringType,
+				"scope":        types.StringType,
+				"min_length":   types.Int64Type,
+				"max_length":   types.Int64Type,
+				"truncated":    types.BoolType,
+			},
+		},
+	}
+}
+
+// Run executes the resource name function.
+func (f ResourceNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var resourceType string
+	var components map[string]string
+	var conventionArgument types.Dynamic
+
+	// Parse the arguments
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &resourceType, &components, &conventionArgument))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input arguments
+	if resourceType == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The resource_type argument must be provided and valid"))
+		return
+	}
+	convention, err := parseResourceNamingConvention(conventionArgument)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error parsing convention: %s", err.Error())))
+		return
+	}
+
+	// Generate the resource name
+	name, err := GenerateResourceName(resourceType, components, convention)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error generating resource name: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, name))
+}
+
+// parseResourceNamingConvention converts the convention argument into a resource naming convention.
+func parseResourceNamingConvention(value types.Dynamic) (ResourceNamingConvention, error) {
+	convention := DefaultResourceNamingConvention()
+	converted, err := dynamicToGo(value)
+	if err != nil || converted == nil {
+		return convention, err
+	}
+	object, err := goObject(converted, "convention")
+	if err != nil {
+		return convention, err
+	}
+	if err := checkObjectKeys(object, "convention", "order", "separator", "hash_length"); err != nil {
+		return convention, err
+	}
+
+	if object["order"] != nil {
+		if convention.Order, err = goStringList(object["order"], "convention.order"); err != nil {
+			return convention, err
+		}
+	}
+	if object["separator"] != nil {
+		if convention.Separator, err = goString(object["separator"], "convention.separator"); err != nil {
+			return convention, err
+		}
+	}
+	if object["hash_length"] != nil {
+		hashLength, err := goInt64(object["hash_length"], "convention.hash_length")
+		if err != nil {
+			return convention, err
+		}
+		convention.HashLength = int(hashLength)
+	}
+	return convention, nil
+}
@@ -0,0 +1,162 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestResourceNameFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		result    string
+	}{
+		"storage-account": {
+			arguments: `"azurerm_storage_account", { workload = "Payments-API", environment = "prod", region = "weu", instance = "001" }, null`,
+			result:    `{"abbreviation":"st","max_length":24,"min_length":3,"name":"stpaymentsapiprodweu001","scope":"global","truncated":false}`,
+		},
+		"storage-account-truncated": {
+			arguments: `"azurerm_storage_account", { workload = "customerpaymentsplatform", environment = "production", region = "westeurope", instance = "001" }, null`,
+			result:    `{"abbreviation":"st","max_length":24,"min_length":3,"name":"stcustoproduweste0014mon","scope":"global","truncated":true}`,
+		},
+		"key-vault": {
+			arguments: `"azurerm_key_vault", { workload = "payments", environment = "prod", region = "weu", instance = "001" }, null`,
+			result:    `{"abbreviation":"kv","max_length":24,"min_length":3,"name":"kv-payments-prod-weu-001","scope":"global","truncated":false}`,
+		},
+		"key-vault-truncated": {
+			arguments: `"azurerm_key_vault", { workload = "customer-payments", environment = "production", region = "westeurope" }, null`,
+			result:    `{"abbreviation":"kv","max_length":24,"min_length":3,"name":"kv-cust-produ-weste-2079","scope":"global","truncated":true}`,
+		},
+		"s3-bucket": {
+			arguments: `"aws_s3_bucket", { workload = "app_logs", environment = "dev" }, null`,
+			result:    `{"abbreviation":"s3","max_length":63,"min_length":3,"name":"s3-applogs-dev","scope":"global","truncated":false}`,
+		},
+		"windows-vm": {
+			arguments: `"azurerm_windows_virtual_machine", { workload = "sqlserver", environment = "prod", instance = "01" }, null`,
+			result:    `{"abbreviation":"vm","max_length":15,"min_length":1,"name":"vm-s-pr-01-5uj5","scope":"resource_group","truncated":true}`,
+		},
+		"custom-convention": {
+			arguments: `"google_compute_instance", { workload = "Web", environment = "prod", region = "euw1" }, { order = ["environment", "workload", "abbreviation", "region"], separator = "-" }`,
+			result:    `{"abbreviation":"vm","max_length":63,"min_length":1,"name":"prod-web-vm-euw1","scope":"region","truncated":false}`,
+		},
+		"abbreviation-override": {
+			arguments: `"azurerm_resource_group", { abbreviation = "RG", workload = "network" }, { separator = "_" }`,
+			result:    `{"abbreviation":"RG","max_length":90,"min_length":1,"name":"RG_network","scope":"subscription","truncated":false}`,
+		},
+		"underscore-separator-dropped": {
+			arguments: `"google_bigquery_dataset", { workload = "sales", environment = "prod" }, null`,
+			result:    `{"abbreviation":"bq","max_length":1024,"min_length":1,"name":"bqsalesprod","scope":"project","truncated":false}`,
+		},
+		"hash-length": {
+			arguments: `"aws_lb", { workload = "internal-payments-gateway", environment = "production" }, { hash_length = 8 }`,
+			result:    `{"abbreviation":"lb","max_length":32,"min_length":1,"name":"lb-internal-production-xx6rgaeh","scope":"region","truncated":true}`,
+		},
+		"resource-group-unicode-truncated": {
+			arguments: `"azurerm_resource_group", { workload = join("", [for i in range(20) : "größe"]) }, null`,
+			result:    `{"abbreviation":"rg","max_length":90,"min_length":1,"name":"rg-größegrößegrößegrößegrößegrößegrößegrößegrößegrößegrößegrößegrößegrößegrößegrößegr-w1aj","scope":"subscription","truncated":true}`,
+		},
+		"case-insensitive-type": {
+			arguments: `"AzureRM_Key_Vault", { workload = "app" }, null`,
+			result:    `{"abbreviation":"kv","max_length":24,"min_length":3,"name":"kv-app","scope":"global","truncated":false}`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::resource_name(%s))
+							}
+						`, testCase.arguments),
+						Check: resource.TestCheckOutput("result", testCase.result),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestResourceNameFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		error     string
+	}{
+		"unknown-type": {
+			arguments: `"azurerm_unknown", { workload = "app" }, null`,
+			error:     `(?s)Call to function "provider::iactools::resource_name" failed.*Error\s+generating\s+resource\s+name:\s+unknown\s+resource\s+type\s+"azurerm_unknown"`,
+		},
+		"invalid-characters": {
+			arguments: `"azurerm_storage_account", { workload = "pay$" }, null`,
+			error:     `(?s)Call to function "provider::iactools::resource_name" failed.*Error\s+generating\s+resource\s+name:\s+cannot\s+build\s+a\s+name\s+of\s+3\s+to\s+24\s+characters\s+for\s+azurerm_storage_account:\s+component\s+workload\s+"pay\$"\s+contains\s+the\s+characters\s+"\$"\s+that\s+are\s+not\s+allowed\s+\(rule\s+characters,\s+characters\s+"\$"\)`,
+		},
+		"too-short": {
+			arguments: `"azurerm_storage_account", { abbreviation = "s" }, { order = ["abbreviation"] }`,
+			error:     `(?s)Call to function "provider::iactools::resource_name" failed.*Error\s+generating\s+resource\s+name:\s+the\s+name\s+"s"\s+does\s+not\s+satisfy\s+the\s+rules\s+of\s+azurerm_storage_account:\s+the\s+name\s+is\s+shorter\s+than\s+3\s+characters\s+\(rule\s+min_length,\s+limit\s+3\)`,
+		},
+		"cannot-shorten": {
+			arguments: `"aws_lb", { abbreviation = "internal-load-balancer-abbreviation" }, null`,
+			error:     `(?s)Call to function "provider::iactools::resource_name" failed.*Error\s+generating\s+resource\s+name:\s+cannot\s+build\s+a\s+name\s+of\s+1\s+to\s+32\s+characters\s+for\s+aws_lb:\s+the\s+name\s+"internal-load-balancer-abbreviation"\s+cannot\s+be\s+shortened\s+to\s+32\s+characters\s+with\s+a\s+hash\s+suffix\s+of\s+4\s+characters\s+\(rule\s+max_length,\s+limit\s+32\)`,
+		},
+		"empty-components": {
+			arguments: `"azurerm_storage_account", { abbreviation = "" }, null`,
+			error:     `(?s)Call to function "provider::iactools::resource_name" failed.*Error\s+generating\s+resource\s+name:\s+cannot\s+build\s+a\s+name\s+of\s+3\s+to\s+24\s+characters\s+for\s+azurerm_storage_account:\s+the\s+components\s+are\s+empty\s+\(rule\s+empty\)`,
+		},
+		"unknown-component": {
+			arguments: `"azurerm_key_vault", { team = "ops" }, null`,
+			error:     `(?s)Call to function "provider::iactools::resource_name" failed.*Error\s+generating\s+resource\s+name:\s+component\s+team\s+is\s+not\s+in\s+the\s+convention\s+order\s+\[abbreviation\s+workload\s+environment\s+region\s+instance\]`,
+		},
+		"duplicate-order": {
+			arguments: `"azurerm_key_vault", { workload = "app" }, { order = ["workload", "workload"] }`,
+			error:     `(?s)Call to function "provider::iactools::resource_name" failed.*Error\s+generating\s+resource\s+name:\s+the\s+convention\s+order\s+contains\s+the\s+component\s+workload\s+more\s+than\s+once`,
+		},
+		"hash-length-range": {
+			arguments: `"azurerm_key_vault", { workload = "app" }, { hash_length = 17 }`,
+			error:     `(?s)Call to function "provider::iactools::resource_name" failed.*Error\s+generating\s+resource\s+name:\s+the\s+hash\s+length\s+must\s+be\s+between\s+1\s+and\s+16`,
+		},
+		"unsupported-attribute": {
+			arguments: `"azurerm_key_vault", { workload = "app" }, { prefix = "x" }`,
+			error:     `(?s)Call to function "provider::iactools::resource_name" failed.*Error\s+parsing\s+convention:\s+convention\s+has\s+unsupported\s+attributes:\s+\[prefix\]`,
+		},
+		"empty-resource-type": {
+			arguments: `"", { workload = "app" }, null`,
+			error:     `(?s)Call to function "provider::iactools::resource_name" failed.*The\s+resource_type\s+argument\s+must\s+be\s+provided\s+and\s+valid`,
+		},
+		"reserved-word": {
+			arguments: `"azurerm_linux_web_app", { abbreviation = "", workload = "login", environment = "prod" }, null`,
+			error:     `(?s)Call to function "provider::iactools::resource_name" failed.*Error\s+generating\s+resource\s+name:\s+the\s+name\s+"login-prod"\s+does\s+not\s+satisfy\s+the\s+rules\s+of\s+azurerm_linux_web_app:\s+the\s+name\s+must\s+not\s+start\s+with\s+the\s+reserved\s+prefix\s+"login"\s+\(rule\s+reserved_prefix,\s+word\s+"login"\)`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::resource_name(%s)
+							}
+						`, testCase.arguments),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
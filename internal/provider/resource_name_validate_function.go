@@ -0,0 +1,108 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = ResourceNameValidateFunction{}
+)
+
+// NewResourceNameValidateFunction is a helper function to create a new instance of ResourceNameValidateFunction.
+func NewResourceNameValidateFunction() function.Function {
+	return ResourceNameValidateFunction{}
+}
+
+// ResourceNameValidateFunction is the struct for the resource name validate function.
+type ResourceNameValidateFunction struct{}
+
+// resourceNameValidation is the result of the resource name validate function.
+type resourceNameValidation struct {
+	Valid    bool                  `tfsdk:"valid"`
+	Problems []string              `tfsdk:"problems"`
+	Details  []ResourceNameProblem `tfsdk:"details"`
+}
+
+// Metadata sets the metadata for the function.
+func (f ResourceNameValidateFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "resource_name_validate"
+}
+
+// Definition sets the definition for the function.
+func (f ResourceNameValidateFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Validate a cloud resource name against the naming rules of its resource type",
+		MarkdownDescription: "Checks a name against the rules of the Azure, AWS or GCP resource type from the dataset embedded in the provider, " +
+			"and outputs whether it is `valid` and the list of `problems` found: the minimum and maximum length, the case, the allowed characters, " +
+			"the characters the name must start and end with, consecutive hyphens or periods, and reserved words, " +
+			"like `microsoft` or a `login` prefix in the names of Azure resources that are part of a public DNS name, or an `xn--` prefix in S3 bucket names. " +
+			"The `details` list holds the same problems as objects with the `rule` that failed, e.g. `max_length`, `characters` or `reserved_prefix`, the `message`, " +
+			"the length `limit` of the length rules, the offending `characters` of the character rules, and the reserved `word` of the reserved word rules, the attributes a rule has no value for are null. " +
+			"Use the result in a `validation` block of a variable to reject invalid names during the plan instead of during the apply. " +
+			"The rules are the ones `resource_name` applies to the names it generates.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "resource_type",
+				MarkdownDescription: "The Terraform resource type, e.g. `azurerm_key_vault`, `aws_s3_bucket` or `google_storage_bucket`",
+			},
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "The name to validate",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"valid":    types.BoolType,
+				"problems": types.ListType{ElemType: types.StringType},
+				"details": types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{
+					"rule":       types.StringType,
+					"message":    types.StringType,
+					"limit":      types.Int64Type,
+					"characters": types.StringType,
+					"word":       types.StringType,
+				}}},
+			},
+		},
+	}
+}
+
+// Run executes the resource name validate function.
+func (f ResourceNameValidateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var resourceType, name string
+
+	// Parse the arguments
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &resourceType, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input arguments
+	if resourceType == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The resource_type argument must be provided and valid"))
+		return
+	}
+
+	// Validate the resource name
+	problems, err := ValidateResourceName(resourceType, name)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error validating resource name: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, resourceNameValidation{
+		Valid:    len(problems) == 0,
+		Problems: resourceNameProblemMessages(problems),
+		Details:  problems,
+	}))
+}
@@ -0,0 +1,181 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestResourceNameValidateFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		result    string
+	}{
+		"valid-key-vault": {
+			arguments: `"azurerm_key_vault", "kv-payments-prod-weu-001"`,
+			result:    `{"problems":[],"valid":true}`,
+		},
+		"valid-storage-account": {
+			arguments: `"azurerm_storage_account", "stpaymentsprod001"`,
+			result:    `{"problems":[],"valid":true}`,
+		},
+		"too-long": {
+			arguments: `"azurerm_storage_account", "stpaymentsproductionwesteurope001"`,
+			result:    `{"problems":["the name is longer than 24 characters"],"valid":false}`,
+		},
+		"too-short": {
+			arguments: `"azurerm_key_vault", "kv"`,
+			result:    `{"problems":["the name is shorter than 3 characters"],"valid":false}`,
+		},
+		"empty": {
+			arguments: `"aws_lb", ""`,
+			result:    `{"problems":["the name is empty"],"valid":false}`,
+		},
+		"case-and-characters": {
+			arguments: `"azurerm_storage_account", "St_Payments"`,
+			result:    `{"problems":["the name must be lowercase","the name contains the characters \"_\" that are not allowed, the allowed characters are [a-z0-9]"],"valid":false}`,
+		},
+		"start-end-consecutive": {
+			arguments: `"azurerm_key_vault", "1kv--payments-"`,
+			result:    `{"problems":["the name must start with one of [a-zA-Z]","the name must end with one of [a-zA-Z0-9]","the name must not contain consecutive \"-\" characters"],"valid":false}`,
+		},
+		"azure-reserved": {
+			arguments: `"azurerm_linux_web_app", "login-microsoft-app"`,
+			result:    `{"problems":["the name must not contain the reserved word \"microsoft\"","the name must not start with the reserved prefix \"login\""],"valid":false}`,
+		},
+		"azure-reserved-word": {
+			arguments: `"azurerm_windows_function_app", "Azure"`,
+			result:    `{"problems":["the name must not be the reserved word \"azure\""],"valid":false}`,
+		},
+		"s3-bucket": {
+			arguments: `"aws_s3_bucket", "xn--logs..archive-s3alias"`,
+			result:    `{"problems":["the name must not contain consecutive \".\" characters","the name must not start with the reserved prefix \"xn--\"","the name must not end with the reserved suffix \"-s3alias\""],"valid":false}`,
+		},
+		"gcp-project": {
+			arguments: `"google_project", "my-google-project"`,
+			result:    `{"problems":["the name must not contain the reserved word \"google\""],"valid":false}`,
+		},
+		"gcp-rfc1035": {
+			arguments: `"google_compute_instance", "web-01"`,
+			result:    `{"problems":[],"valid":true}`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							locals {
+								validation = provider::iactools::resource_name_validate(%s)
+							}
+							output "result" {
+								value = jsonencode({ problems = local.validation.problems, valid = local.validation.valid })
+							}
+						`, testCase.arguments),
+						Check: resource.TestCheckOutput("result", testCase.result),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestResourceNameValidateFunction_Details(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		result    string
+	}{
+		"valid": {
+			arguments: `"azurerm_key_vault", "kv-payments-prod-weu-001"`,
+			result:    `[]`,
+		},
+		"length": {
+			arguments: `"azurerm_storage_account", "stpaymentsproductionweu001"`,
+			result:    `[{"characters":null,"limit":24,"message":"the name is longer than 24 characters","rule":"max_length","word":null}]`,
+		},
+		"characters": {
+			arguments: `"azurerm_storage_account", "St_payments"`,
+			result: `[{"characters":null,"limit":null,"message":"the name must be lowercase","rule":"case","word":null},` +
+				`{"characters":"_","limit":null,"message":"the name contains the characters \"_\" that are not allowed, the allowed characters are [a-z0-9]","rule":"characters","word":null}]`,
+		},
+		"start-end-consecutive": {
+			arguments: `"azurerm_key_vault", "1kv--payments-"`,
+			result: `[{"characters":"1","limit":null,"message":"the name must start with one of [a-zA-Z]","rule":"start","word":null},` +
+				`{"characters":"-","limit":null,"message":"the name must end with one of [a-zA-Z0-9]","rule":"end","word":null},` +
+				`{"characters":"--","limit":null,"message":"the name must not contain consecutive \"-\" characters","rule":"no_consecutive","word":null}]`,
+		},
+		"reserved": {
+			arguments: `"aws_s3_bucket", "xn--logs-s3alias"`,
+			result: `[{"characters":null,"limit":null,"message":"the name must not start with the reserved prefix \"xn--\"","rule":"reserved_prefix","word":"xn--"},` +
+				`{"characters":null,"limit":null,"message":"the name must not end with the reserved suffix \"-s3alias\"","rule":"reserved_suffix","word":"-s3alias"}]`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::resource_name_validate(%s).details)
+							}
+						`, testCase.arguments),
+						Check: resource.TestCheckOutput("result", testCase.result),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestResourceNameValidateFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		error     string
+	}{
+		"unknown-type": {
+			arguments: `"azurerm_unknown", "name"`,
+			error:     `(?s)Call to function "provider::iactools::resource_name_validate" failed.*Error\s+validating\s+resource\s+name:\s+unknown\s+resource\s+type\s+"azurerm_unknown"`,
+		},
+		"empty-resource-type": {
+			arguments: `"", "name"`,
+			error:     `(?s)Call to function "provider::iactools::resource_name_validate" failed.*The\s+resource_type\s+argument\s+must\s+be\s+provided\s+and\s+valid`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::resource_name_validate(%s)
+							}
+						`, testCase.arguments),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,512 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// resourceNamingRulesJSON is the embedded dataset of the naming rules of cloud resource types.
+//
+//go:embed data/resource_naming_rules.json
+var resourceNamingRulesJSON []byte
+
+// Name case rules of the resource types.
+const (
+	nameCaseAny   = "any"
+	nameCaseLower = "lower"
+	nameCaseUpper = "upper"
+)
+
+// resourceNameAbbreviation is the component replaced with the abbreviation of the resource type.
+const resourceNameAbbreviation = "abbreviation"
+
+// resourceNameWordSeparators are removed from the components when the resource type does not allow them.
+const resourceNameWordSeparators = "-_."
+
+// defaultResourceNameOrder is the order of the components of the default naming convention.
+var defaultResourceNameOrder = []string{resourceNameAbbreviation, "workload", "environment", "region", "instance"}
+
+// Defaults and limits of the naming convention.
+const (
+	defaultResourceNameSeparator  = "-"
+	defaultResourceNameHashLength = 4
+	maxResourceNameHashLength     = 16
+)
+
+// resourceNamingDataset is the structure of the embedded resource naming rules dataset.
+type resourceNamingDataset struct {
+	Version       string                               `json:"version"`
+	ReservedWords map[string]resourceNameReservedWords `json:"reserved_words"`
+	ResourceTypes map[string]resourceNamingRule        `json:"resource_types"`
+}
+
+// resourceNameReservedWords holds the words a name must not be, contain, start or end with.
+type resourceNameReservedWords struct {
+	Words    []string `json:"words"`
+	Contains []string `json:"contains"`
+	Prefixes []string `json:"prefixes"`
+	Suffixes []string `json:"suffixes"`
+}
+
+// resourceNamingRule holds the naming rules of a resource type.
+type resourceNamingRule struct {
+	Abbreviation  string `json:"abbreviation"`
+	MinLength     int    `json:"min_length"`
+	MaxLength     int    `json:"max_length"`
+	Characters    string `json:"characters"`
+	Case          string `json:"case"`
+	Start         string `json:"start"`
+	End           string `json:"end"`
+	NoConsecutive string `json:"no_consecutive"`
+	Reserved      string `json:"reserved"`
+	Scope         string `json:"scope"`
+
+	reservedWords    resourceNameReservedWords
+	allowedRegexp    *regexp.Regexp
+	disallowedRegexp *regexp.Regexp
+	startRegexp      *regexp.Regexp
+	endRegexp        *regexp.Regexp
+}
+
+// Rules reported by the problems of a resource name.
+const (
+	resourceNameRuleEmpty            = "empty"
+	resourceNameRuleMinLength        = "min_length"
+	resourceNameRuleMaxLength        = "max_length"
+	resourceNameRuleCase             = "case"
+	resourceNameRuleCharacters       = "characters"
+	resourceNameRuleStart            = "start"
+	resourceNameRuleEnd              = "end"
+	resourceNameRuleNoConsecutive    = "no_consecutive"
+	resourceNameRuleReservedWord     = "reserved_word"
+	resourceNameRuleReservedContains = "reserved_contains"
+	resourceNameRuleReservedPrefix   = "reserved_prefix"
+	resourceNameRuleReservedSuffix   = "reserved_suffix"
+)
+
+// ResourceNameProblem holds a naming rule that a name does not satisfy, with the limit or the offending characters or word when the rule has one.
+type ResourceNameProblem struct {
+	Rule       string  `tfsdk:"rule"`
+	Message    string  `tfsdk:"message"`
+	Limit      *int64  `tfsdk:"limit"`
+	Characters *string `tfsdk:"characters"`
+	Word       *string `tfsdk:"word"`
+}
+
+// ResourceNameError is the error of a name that cannot satisfy the naming rules of a resource type, with the problems found.
+type ResourceNameError struct {
+	ResourceType string
+	Name         string
+	MinLength    int
+	MaxLength    int
+	Problems     []ResourceNameProblem
+}
+
+// ResourceNamingConvention holds the order of the components, the separator and the length of the hash suffix of truncated names.
+type ResourceNamingConvention struct {
+	Order      []string
+	Separator  string
+	HashLength int
+}
+
+// ResourceName holds a generated resource name with the rules it satisfies.
+type ResourceName struct {
+	Name         string `tfsdk:"name"`
+	Abbreviation string `tfsdk:"abbreviation"`
+	Scope        string `tfsdk:"scope"`
+	MinLength    int64  `tfsdk:"min_length"`
+	MaxLength    int64  `tfsdk:"max_length"`
+	Truncated    bool   `tfsdk:"truncated"`
+}
+
+// loadResourceNamingDataset parses the embedded dataset once, and resolves the reserved words and compiles the character classes of every resource type.
+var loadResourceNamingDataset = sync.OnceValues(func() (*resourceNamingDataset, error) {
+	var dataset resourceNamingDataset
+	if err := json.Unmarshal(resourceNamingRulesJSON, &dataset); err != nil {
+		return nil, fmt.Errorf("cannot parse the resource naming rules dataset: %v", err)
+	}
+	for resourceType, rule := range dataset.ResourceTypes {
+		if rule.Reserved != "" {
+			var ok bool
+			if rule.reservedWords, ok = dataset.ReservedWords[rule.Reserved]; !ok {
+				return nil, fmt.Errorf("unknown reserved words %q of resource type %q", rule.Reserved, resourceType)
+			}
+		}
+		if err := rule.compile(); err != nil {
+			return nil, fmt.Errorf("invalid naming rules of resource type %q: %v", resourceType, err)
+		}
+		dataset.ResourceTypes[resourceType] = rule
+	}
+	return &dataset, nil
+})
+
+// compile compiles the character classes of the rule.
+func (rule *resourceNamingRule) compile() error {
+	var err error
+	if rule.allowedRegexp, err = regexp.Compile(`^[` + rule.Characters + `]*$`); err != nil {
+		return fmt.Errorf("invalid characters [%s]: %v", rule.Characters, err)
+	}
+	if rule.disallowedRegexp, err = regexp.Compile(`[^` + rule.Characters + `]`); err != nil {
+		return fmt.Errorf("invalid characters [%s]: %v", rule.Characters, err)
+	}
+	if rule.Start != "" {
+		if rule.startRegexp, err = regexp.Compile(`^[` + rule.Start + `]`); err != nil {
+			return fmt.Errorf("invalid start characters [%s]: %v", rule.Start, err)
+		}
+	}
+	if rule.End != "" {
+		if rule.endRegexp, err = regexp.Compile(`[` + rule.End + `]$`); err != nil {
+			return fmt.Errorf("invalid end characters [%s]: %v", rule.End, err)
+		}
+	}
+	return nil
+}
+
+// DefaultResourceNamingConvention returns the naming convention used when none is provided.
+func DefaultResourceNamingConvention() ResourceNamingConvention {
+	return ResourceNamingConvention{
+		Order:      defaultResourceNameOrder,
+		Separator:  defaultResourceNameSeparator,
+		HashLength: defaultResourceNameHashLength,
+	}
+}
+
+// resourceNamingRuleFor looks up the naming rules of a resource type.
+func resourceNamingRuleFor(resourceType string) (resourceNamingRule, error) {
+	dataset, err := loadResourceNamingDataset()
+	if err != nil {
+		return resourceNamingRule{}, err
+	}
+	rule, ok := dataset.ResourceTypes[strings.ToLower(resourceType)]
+	if !ok {
+		return resourceNamingRule{}, fmt.Errorf("unknown resource type %q", resourceType)
+	}
+	return rule, nil
+}
+
+// allows reports whether every character of a value is allowed by the rule.
+func (rule resourceNamingRule) allows(value string) bool {
+	return rule.allowedRegexp.MatchString(value)
+}
+
+// disallowed returns the distinct characters of a value that the rule does not allow.
+func (rule resourceNamingRule) disallowed(value string) string {
+	var result strings.Builder
+	for _, match := range rule.disallowedRegexp.FindAllString(value, -1) {
+		if !strings.Contains(result.String(), match) {
+			result.WriteString(match)
+		}
+	}
+	return result.String()
+}
+
+// problems returns the rules of the resource type that a name does not satisfy.
+func (rule resourceNamingRule) problems(name string) []ResourceNameProblem {
+	problems := make([]ResourceNameProblem, 0)
+	if name == "" {
+		return append(problems, ResourceNameProblem{Rule: resourceNameRuleEmpty, Message: "the name is empty"})
+	}
+	length := utf8.RuneCountInString(name)
+	if length < rule.MinLength {
+		problems = append(problems, ResourceNameProblem{
+			Rule:    resourceNameRuleMinLength,
+			Message: fmt.Sprintf("the name is shorter than %d characters", rule.MinLength),
+			Limit:   int64Pointer(int64(rule.MinLength)),
+		})
+	}
+	if length > rule.MaxLength {
+		problems = append(problems, ResourceNameProblem{
+			Rule:    resourceNameRuleMaxLength,
+			Message: fmt.Sprintf("the name is longer than %d characters", rule.MaxLength),
+			Limit:   int64Pointer(int64(rule.MaxLength)),
+		})
+	}
+
+	// A name in the wrong case is reported once instead of as disallowed characters
+	if cased := rule.applyCase(name); cased != name {
+		problems = append(problems, ResourceNameProblem{Rule: resourceNameRuleCase, Message: fmt.Sprintf("the name must be %scase", rule.Case)})
+		name = cased
+	}
+	if invalid := rule.disallowed(name); invalid != "" {
+		problems = append(problems, ResourceNameProblem{
+			Rule:       resourceNameRuleCharacters,
+			Message:    fmt.Sprintf("the name contains the characters %q that are not allowed, the allowed characters are [%s]", invalid, rule.Characters),
+			Characters: &invalid,
+		})
+	}
+	if rule.startRegexp != nil && !rule.startRegexp.MatchString(name) {
+		first := string([]rune(name)[:1])
+		problems = append(problems, ResourceNameProblem{
+			Rule:       resourceNameRuleStart,
+			Message:    fmt.Sprintf("the name must start with one of [%s]", rule.Start),
+			Characters: &first,
+		})
+	}
+	if rule.endRegexp != nil && !rule.endRegexp.MatchString(name) {
+		_, size := utf8.DecodeLastRuneInString(name)
+		last := name[len(name)-size:]
+		problems = append(problems, ResourceNameProblem{
+			Rule:       resourceNameRuleEnd,
+			Message:    fmt.Sprintf("the name must end with one of [%s]", rule.End),
+			Characters: &last,
+		})
+	}
+	for _, char := range rule.NoConsecutive {
+		if consecutive := strings.Repeat(string(char), 2); strings.Contains(name, consecutive) {
+			problems = append(problems, ResourceNameProblem{
+				Rule:       resourceNameRuleNoConsecutive,
+				Message:    fmt.Sprintf("the name must not contain consecutive %q characters", string(char)),
+				Characters: &consecutive,
+			})
+		}
+	}
+
+	// Reserved words are matched regardless of case
+	lower := strings.ToLower(name)
+	for _, word := range rule.reservedWords.Words {
+		if lower == word {
+			problems = append(problems, resourceNameReservedProblem(resourceNameRuleReservedWord, "the name must not be the reserved word %q", word))
+		}
+	}
+	for _, word := range rule.reservedWords.Contains {
+		if strings.Contains(lower, word) {
+			problems = append(problems, resourceNameReservedProblem(resourceNameRuleReservedContains, "the name must not contain the reserved word %q", word))
+		}
+	}
+	for _, prefix := range rule.reservedWords.Prefixes {
+		if strings.HasPrefix(lower, prefix) {
+			problems = append(problems, resourceNameReservedProblem(resourceNameRuleReservedPrefix, "the name must not start with the reserved prefix %q", prefix))
+		}
+	}
+	for _, suffix := range rule.reservedWords.Suffixes {
+		if strings.HasSuffix(lower, suffix) {
+			problems = append(problems, resourceNameReservedProblem(resourceNameRuleReservedSuffix, "the name must not end with the reserved suffix %q", suffix))
+		}
+	}
+	return problems
+}
+
+// resourceNameReservedProblem returns the problem of a name matching a reserved word.
+func resourceNameReservedProblem(rule, format, word string) ResourceNameProblem {
+	return ResourceNameProblem{Rule: rule, Message: fmt.Sprintf(format, word), Word: &word}
+}
+
+// resourceNameProblemMessages returns the messages of the problems.
+func resourceNameProblemMessages(problems []ResourceNameProblem) []string {
+	messages := make([]string, 0, len(problems))
+	for _, problem := range problems {
+		messages = append(messages, problem.Message)
+	}
+	return messages
+}
+
+// applyCase converts a value to the case required by the rule.
+func (rule resourceNamingRule) applyCase(value string) string {
+	switch rule.Case {
+	case nameCaseLower:
+		return strings.ToLower(value)
+	case nameCaseUpper:
+		return strings.ToUpper(value)
+	}
+	return value
+}
+
+// GenerateResourceName builds the name of a resource from its components following the naming convention and the rules of the resource type.
+// Names longer than the maximum length are shortened by truncating the longest components and appending a hash of the full name.
+func GenerateResourceName(resourceType string, components map[string]string, convention ResourceNamingConvention) (ResourceName, error) {
+	rule, err := resourceNamingRuleFor(resourceType)
+	if err != nil {
+		return ResourceName{}, err
+	}
+	if err := validateResourceNamingConvention(convention, components); err != nil {
+		return ResourceName{}, err
+	}
+
+	abbreviation := rule.Abbreviation
+	if value, ok := components[resourceNameAbbreviation]; ok {
+		abbreviation = value
+	}
+
+	// A separator the resource type does not allow is dropped
+	separator := convention.Separator
+	if !rule.allows(separator) {
+		separator = ""
+	}
+
+	// Collect the components in the order of the convention
+	var problems []ResourceNameProblem
+	var parts []string
+	truncatable := map[int]bool{}
+	for _, key := range convention.Order {
+		value, ok := components[key]
+		if key == resourceNameAbbreviation {
+			value, ok = abbreviation, true
+		}
+		if !ok {
+			continue
+		}
+		value = rule.applyCase(value)
+		for _, char := range resourceNameWordSeparators {
+			if !rule.allows(string(char)) {
+				value = strings.ReplaceAll(value, string(char), "")
+			}
+		}
+		if value == "" {
+			continue
+		}
+		if invalid := rule.disallowed(value); invalid != "" {
+			problems = append(problems, ResourceNameProblem{
+				Rule:       resourceNameRuleCharacters,
+				Message:    fmt.Sprintf("component %s %q contains the characters %q that are not allowed", key, value, invalid),
+				Characters: &invalid,
+			})
+			continue
+		}
+		truncatable[len(parts)] = key != resourceNameAbbreviation
+		parts = append(parts, value)
+	}
+	if len(problems) > 0 {
+		return ResourceName{}, newResourceNameError(resourceType, rule, "", problems)
+	}
+	if len(parts) == 0 {
+		return ResourceName{}, newResourceNameError(resourceType, rule, "", []ResourceNameProblem{{Rule: resourceNameRuleEmpty, Message: "the components are empty"}})
+	}
+
+	result := ResourceName{
+		Name:         strings.Join(parts, separator),
+		Abbreviation: abbreviation,
+		Scope:        rule.Scope,
+		MinLength:    int64(rule.MinLength),
+		MaxLength:    int64(rule.MaxLength),
+	}
+
+	// Truncate the longest components and append a hash of the full name
+	if utf8.RuneCountInString(result.Name) > rule.MaxLength {
+		suffix, err := StableSuffix([]string{result.Name}, convention.HashLength, suffixAlphabetBase36)
+		if err != nil {
+			return ResourceName{}, err
+		}
+		suffix = rule.applyCase(suffix)
+		available := rule.MaxLength - len(suffix) - utf8.RuneCountInString(separator)
+		for joinedLength(parts, separator) > available {
+			longest, longestLength := -1, 1
+			for i, part := range parts {
+				if length := utf8.RuneCountInString(part); truncatable[i] && length > longestLength {
+					longest, longestLength = i, length
+				}
+			}
+			if longest < 0 {
+				return ResourceName{}, newResourceNameError(resourceType, rule, "", []ResourceNameProblem{{
+					Rule:    resourceNameRuleMaxLength,
+					Message: fmt.Sprintf("the name %q cannot be shortened to %d characters with a hash suffix of %d characters", result.Name, rule.MaxLength, len(suffix)),
+					Limit:   int64Pointer(int64(rule.MaxLength)),
+				}})
+			}
+
+			// Components are cut by characters, not bytes, to keep multibyte characters whole
+			runes := []rune(parts[longest])
+			parts[longest] = strings.TrimRight(string(runes[:len(runes)-1]), resourceNameWordSeparators)
+			if parts[longest] == "" {
+				parts[longest] = string(runes[:1])
+			}
+		}
+		result.Name = strings.Join(append(parts, suffix), separator)
+		result.Truncated = true
+	}
+
+	if problems := rule.problems(result.Name); len(problems) > 0 {
+		return ResourceName{}, newResourceNameError(resourceType, rule, result.Name, problems)
+	}
+	return result, nil
+}
+
+// ValidateResourceName returns the naming rules of a resource type that a name does not satisfy.
+func ValidateResourceName(resourceType, name string) ([]ResourceNameProblem, error) {
+	rule, err := resourceNamingRuleFor(resourceType)
+	if err != nil {
+		return nil, err
+	}
+	return rule.problems(name), nil
+}
+
+// validateResourceNamingConvention checks the order, the hash length and the components of a naming convention.
+func validateResourceNamingConvention(convention ResourceNamingConvention, components map[string]string) error {
+	if len(convention.Order) == 0 {
+		return fmt.Errorf("the convention order must not be empty")
+	}
+	seen := map[string]bool{}
+	for _, key := range convention.Order {
+		if key == "" {
+			return fmt.Errorf("the convention order must not contain empty component names")
+		}
+		if seen[key] {
+			return fmt.Errorf("the convention order contains the component %s more than once", key)
+		}
+		seen[key] = true
+	}
+	for key := range components {
+		if !seen[key] {
+			return fmt.Errorf("component %s is not in the convention order %v", key, convention.Order)
+		}
+	}
+	if convention.HashLength < 1 || convention.HashLength > maxResourceNameHashLength {
+		return fmt.Errorf("the hash length must be between 1 and %d", maxResourceNameHashLength)
+	}
+	return nil
+}
+
+// newResourceNameError returns the error of the problems that prevent a name from satisfying the rules of a resource type.
+func newResourceNameError(resourceType string, rule resourceNamingRule, name string, problems []ResourceNameProblem) *ResourceNameError {
+	return &ResourceNameError{
+		ResourceType: strings.ToLower(resourceType),
+		Name:         name,
+		MinLength:    rule.MinLength,
+		MaxLength:    rule.MaxLength,
+		Problems:     problems,
+	}
+}
+
+// Error lists the problems with the rule that failed and its limit, offending characters or reserved word.
+func (e *ResourceNameError) Error() string {
+	problems := make([]string, 0, len(e.Problems))
+	for _, problem := range e.Problems {
+		problems = append(problems, problem.String())
+	}
+	if e.Name != "" {
+		return fmt.Sprintf("the name %q does not satisfy the rules of %s: %s", e.Name, e.ResourceType, strings.Join(problems, "; "))
+	}
+	return fmt.Sprintf("cannot build a name of %d to %d characters for %s: %s", e.MinLength, e.MaxLength, e.ResourceType, strings.Join(problems, "; "))
+}
+
+// String formats the problem as its message followed by the rule that failed and its limit, offending characters or reserved word.
+func (problem ResourceNameProblem) String() string {
+	attributes := []string{"rule " + problem.Rule}
+	if problem.Limit != nil {
+		attributes = append(attributes, fmt.Sprintf("limit %d", *problem.Limit))
+	}
+	if problem.Characters != nil {
+		attributes = append(attributes, fmt.Sprintf("characters %q", *problem.Characters))
+	}
+	if problem.Word != nil {
+		attributes = append(attributes, fmt.Sprintf("word %q", *problem.Word))
+	}
+	return fmt.Sprintf("%s (%s)", problem.Message, strings.Join(attributes, ", "))
+}
+
+// joinedLength returns the number of characters of the parts joined with the separator.
+func joinedLength(parts []string, separator string) int {
+	length := utf8.RuneCountInString(separator) * (len(parts) - 1)
+	for _, part := range parts {
+		length += utf8.RuneCountInString(part)
+	}
+	return length
+}
@@ -0,0 +1,52 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+)
+
+func TestResourceNamingDataset(t *testing.T) {
+	dataset, err := loadResourceNamingDataset()
+	if err != nil {
+		t.Fatalf("cannot load the resource naming rules dataset: %v", err)
+	}
+	if len(dataset.ResourceTypes) == 0 {
+		t.Fatal("the resource naming rules dataset has no resource types")
+	}
+	for resourceType, rule := range dataset.ResourceTypes {
+		t.Run(resourceType, func(t *testing.T) {
+			if rule.Abbreviation == "" {
+				t.Error("the abbreviation is empty")
+			}
+			if rule.Scope == "" {
+				t.Error("the scope is empty")
+			}
+			if rule.MinLength < 1 {
+				t.Errorf("the minimum length %d is less than 1", rule.MinLength)
+			}
+			if rule.MinLength > rule.MaxLength {
+				t.Errorf("the minimum length %d is greater than the maximum length %d", rule.MinLength, rule.MaxLength)
+			}
+			switch rule.Case {
+			case nameCaseAny, nameCaseLower, nameCaseUpper:
+			default:
+				t.Errorf("unknown case %q", rule.Case)
+			}
+			if rule.allowedRegexp == nil || rule.disallowedRegexp == nil {
+				t.Error("the allowed characters are not compiled")
+			}
+			if (rule.Start != "") != (rule.startRegexp != nil) || (rule.End != "") != (rule.endRegexp != nil) {
+				t.Error("the start or end characters are not compiled")
+			}
+			if invalid := rule.disallowed(rule.applyCase(rule.Abbreviation)); invalid != "" {
+				t.Errorf("the abbreviation %q contains the characters %q that are not allowed", rule.Abbreviation, invalid)
+			}
+			if rule.startRegexp != nil && !rule.startRegexp.MatchString(rule.applyCase(rule.Abbreviation)) {
+				t.Errorf("the abbreviation %q does not start with one of [%s]", rule.Abbreviation, rule.Start)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+// ReverseZone describes a reverse DNS zone and the prefixes it covers.
+type ReverseZone struct {
+	Zone       string   `tfsdk:"zone"`
+	Prefix     string   `tfsdk:"prefix"`
+	Type       string   `tfsdk:"type"`
+	ParentZone string   `tfsdk:"parent_zone"`
+	Prefixes   []string `tfsdk:"prefixes"`
+}
+
+// Reverse zone types.
+const (
+	reverseZoneStandard  = "standard"
+	reverseZoneClassless = "classless"
+)
+
+// ReverseZonePlan calculates the minimal set of reverse DNS zones that cover the given CIDRs.
+func ReverseZonePlan(cidrs []string) ([]ReverseZone, error) {
+	ipnets, err := parseCIDRList(cidrs)
+	if err != nil {
+		return nil, err
+	}
+	sortIPNets(ipnets)
+
+	var zones []ReverseZone
+	for _, block := range aggregateCIDRs(ipnets) {
+		ones, bits := block.Mask.Size()
+		boundary := reverseZoneBoundary(bits)
+
+		if bits == 8*net.IPv4len && ones > 24 {
+			// Blocks smaller than a /24 are delegated from the /24 zone, as described in RFC 2317
+			zones = append(zones, ReverseZone{
+				Zone:       fmt.Sprintf("%d/%d.%s", block.IP[3], ones, reverseZoneName(block.IP, 24)),
+				Prefix:     block.String(),
+				Type:       reverseZoneClassless,
+				ParentZone: reverseZoneName(block.IP, 24),
+				Prefixes:   coveredCIDRs(ipnets, block),
+			})
+			continue
+		}
+
+		// Blocks between zone boundaries are split into zones at the next boundary
+		zoneOnes := ((ones + boundary - 1) / boundary) * boundary
+		subnets, err := splitCIDRTo(block, zoneOnes)
+		if err != nil {
+			return nil, err
+		}
+		for _, subnet := range subnets {
+			parentZone := "arpa."
+			if zoneOnes > 0 {
+				parentZone = reverseZoneName(subnet.IP, zoneOnes-boundary)
+			}
+			zones = append(zones, ReverseZone{
+				Zone:       reverseZoneName(subnet.IP, zoneOnes),
+				Prefix:     subnet.String(),
+				Type:       reverseZoneStandard,
+				ParentZone: parentZone,
+				Prefixes:   coveredCIDRs(ipnets, subnet),
+			})
+		}
+	}
+
+	return zones, nil
+}
+
+// Helper functions
+
+// reverseZoneBoundary returns the number of bits represented by one label of the reverse zone name.
+func reverseZoneBoundary(bits int) int {
+	if bits == 8*net.IPv4len {
+		return 8
+	}
+	return 4
+}
+
+// reverseZoneName generates the reverse zone name of a network with the given prefix length.
+func reverseZoneName(ip net.IP, ones int) string {
+	var name string
+	var labels int
+	if len(ip) == net.IPv4len {
+		name = ReverseDNSIPv4(ip.String())
+		labels = (32 - ones) / 8
+	} else {
+		name = ReverseDNSIPv6(ip.To16())
+		labels = (128 - ones) / 4
+	}
+
+	// Drop the labels of the host part from the beginning of the PTR name
+	parts := strings.SplitN(name, ".", labels+1)
+	return parts[len(parts)-1]
+}
+
+// splitCIDRTo splits a network into subnets with the given prefix length.
+func splitCIDRTo(ipnet *net.IPNet, ones int) ([]*net.IPNet, error) {
+	current, _ := ipnet.Mask.Size()
+	if current == ones {
+		return []*net.IPNet{ipnet}, nil
+	}
+
+	halves, err := splitCIDR(ipnet)
+	if err != nil {
+		return nil, err
+	}
+
+	lower, err := splitCIDRTo(halves[0], ones)
+	if err != nil {
+		return nil, err
+	}
+	upper, err := splitCIDRTo(halves[1], ones)
+	if err != nil {
+		return nil, err
+	}
+	return append(lower, upper...), nil
+}
+
+// coveredCIDRs returns the input networks that overlap with the given network.
+func coveredCIDRs(ipnets []*net.IPNet, block *net.IPNet) []string {
+	covered := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, ipnet := range ipnets {
+		if ipNetsOverlap(ipnet, block) && !seen[ipnet.String()] {
+			covered = append(covered, ipnet.String())
+			seen[ipnet.String()] = true
+		}
+	}
+	return covered
+}
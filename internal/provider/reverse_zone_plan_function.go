@@ -0,0 +1,87 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = ReverseZonePlanFunction{}
+)
+
+// NewReverseZonePlanFunction is a helper function to create a new instance of ReverseZonePlanFunction.
+func NewReverseZonePlanFunction() function.Function {
+	return ReverseZonePlanFunction{}
+}
+
+// ReverseZonePlanFunction is the struct for the reverse zone plan function.
+type ReverseZonePlanFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f ReverseZonePlanFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "reverse_zone_plan"
+}
+
+// Definition sets the definition for the function.
+func (f ReverseZonePlanFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Plan the reverse DNS zones of a set of CIDRs",
+		MarkdownDescription: "Accepts both IPv4 and IPv6 CIDRs, merges adjacent and overlapping prefixes, and outputs the minimal list of reverse zones. " +
+			"Prefixes between zone boundaries are split into octet (IPv4) or nibble (IPv6) aligned `standard` zones, " +
+			"IPv4 prefixes longer than /24 get a `classless` zone named as described in RFC 2317, delegated from its `parent_zone`.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "cidrs",
+				MarkdownDescription: "The CIDRs of the networks that need reverse DNS",
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"zone":        types.StringType,
+					"prefix":      types.StringType,
+					"type":        types.StringType,
+					"parent_zone": types.StringType,
+					"prefixes":    types.ListType{ElemType: types.StringType},
+				},
+			},
+		},
+	}
+}
+
+// Run executes the reverse zone plan function.
+func (f ReverseZonePlanFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidrs []string
+
+	// Parse the argument
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &cidrs))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input argument
+	if len(cidrs) == 0 {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The cidrs argument must be provided and valid"))
+		return
+	}
+
+	// Plan the reverse zones
+	zones, err := ReverseZonePlan(cidrs)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error planning reverse zones: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, zones))
+}
@@ -0,0 +1,106 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestReverseZonePlanFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		cidrs string
+		zones string
+	}{
+		"ipv4-octet-aligned": {
+			cidrs: `["10.1.0.0/16", "192.168.10.0/24"]`,
+			zones: `[{"parent_zone":"10.in-addr.arpa.","prefix":"10.1.0.0/16","prefixes":["10.1.0.0/16"],"type":"standard","zone":"1.10.in-addr.arpa."},` +
+				`{"parent_zone":"168.192.in-addr.arpa.","prefix":"192.168.10.0/24","prefixes":["192.168.10.0/24"],"type":"standard","zone":"10.168.192.in-addr.arpa."}]`,
+		},
+		"ipv4-split-to-octet-boundary": {
+			cidrs: `["10.1.4.0/23"]`,
+			zones: `[{"parent_zone":"1.10.in-addr.arpa.","prefix":"10.1.4.0/24","prefixes":["10.1.4.0/23"],"type":"standard","zone":"4.1.10.in-addr.arpa."},` +
+				`{"parent_zone":"1.10.in-addr.arpa.","prefix":"10.1.5.0/24","prefixes":["10.1.4.0/23"],"type":"standard","zone":"5.1.10.in-addr.arpa."}]`,
+		},
+		"ipv4-classless": {
+			cidrs: `["10.1.8.0/26", "10.1.8.128/26"]`,
+			zones: `[{"parent_zone":"8.1.10.in-addr.arpa.","prefix":"10.1.8.0/26","prefixes":["10.1.8.0/26"],"type":"classless","zone":"0/26.8.1.10.in-addr.arpa."},` +
+				`{"parent_zone":"8.1.10.in-addr.arpa.","prefix":"10.1.8.128/26","prefixes":["10.1.8.128/26"],"type":"classless","zone":"128/26.8.1.10.in-addr.arpa."}]`,
+		},
+		"ipv4-merged": {
+			cidrs: `["10.1.8.0/26", "10.1.8.64/26", "10.1.8.128/25", "10.1.8.32/27"]`,
+			zones: `[{"parent_zone":"1.10.in-addr.arpa.","prefix":"10.1.8.0/24","prefixes":["10.1.8.0/26","10.1.8.32/27","10.1.8.64/26","10.1.8.128/25"],"type":"standard","zone":"8.1.10.in-addr.arpa."}]`,
+		},
+		"ipv6-nibble-aligned": {
+			cidrs: `["2001:db8:abcd::/48"]`,
+			zones: `[{"parent_zone":"c.b.a.8.b.d.0.1.0.0.2.ip6.arpa.","prefix":"2001:db8:abcd::/48","prefixes":["2001:db8:abcd::/48"],"type":"standard","zone":"d.c.b.a.8.b.d.0.1.0.0.2.ip6.arpa."}]`,
+		},
+		"ipv6-split-to-nibble-boundary": {
+			cidrs: `["2001:db8:abcd:8000::/51"]`,
+			zones: `[{"parent_zone":"d.c.b.a.8.b.d.0.1.0.0.2.ip6.arpa.","prefix":"2001:db8:abcd:8000::/52","prefixes":["2001:db8:abcd:8000::/51"],"type":"standard","zone":"8.d.c.b.a.8.b.d.0.1.0.0.2.ip6.arpa."},` +
+				`{"parent_zone":"d.c.b.a.8.b.d.0.1.0.0.2.ip6.arpa.","prefix":"2001:db8:abcd:9000::/52","prefixes":["2001:db8:abcd:8000::/51"],"type":"standard","zone":"9.d.c.b.a.8.b.d.0.1.0.0.2.ip6.arpa."}]`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::reverse_zone_plan(%s))
+							}
+						`, testCase.cidrs),
+						Check: resource.TestCheckOutput("result", testCase.zones),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestReverseZonePlanFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		cidrs string
+		error string
+	}{
+		"empty-cidrs": {
+			cidrs: `[]`,
+			error: `(?s)Call to function "provider::iactools::reverse_zone_plan" failed.*The cidrs.*argument must be provided and valid`,
+		},
+		"invalid-cidr": {
+			cidrs: `["10.1.0.0/16", "invalid-cidr"]`,
+			error: `(?s)Call to function "provider::iactools::reverse_zone_plan" failed.*invalid CIDR.*address.*invalid-cidr`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::reverse_zone_plan(%s)
+							}
+						`, testCase.cidrs),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = RouteLookupFunction{}
+)
+
+// NewRouteLookupFunction is a helper function to create a new instance of RouteLookupFunction.
+func NewRouteLookupFunction() function.Function {
+	return RouteLookupFunction{}
+}
+
+// RouteLookupFunction is the struct for the route lookup function.
+type RouteLookupFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f RouteLookupFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "route_lookup"
+}
+
+// Definition sets the definition for the function.
+func (f RouteLookupFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Find the route of a destination in a route table",
+		MarkdownDescription: "Selects the route of a destination address or CIDR with longest prefix match, and outputs it with its prefix in canonical form, or null when no route matches. " +
+			"Routes with the same prefix length are resolved by the `mode`: `longest_prefix` takes the first one in the list, " +
+			"`azure` prefers `user` over `bgp` over `system` routes, and ignores `bgp` routes when a `system` route of the virtual network or its peerings matches, " +
+			"`aws` prefers the `system` (local) route over `user` (static) over `bgp` (propagated) routes. " +
+			"The default mode is `longest_prefix`, unlike `route_table_effective` which defaults to `azure`. " +
+			"The output of `route_table_effective` can be passed as the routes, its `invalid` routes are skipped, so use the same mode for both functions.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name: "routes",
+				MarkdownDescription: "The list of routes, each an object with `prefix`, `next_hop_type` and the optional attributes `next_hop` and " +
+					"`source` (`system`, `user` or `bgp`, defaults to `user`, the aliases `default`, `local`, `static` and `propagated` are also accepted). " +
+					"Routes with the `state` attribute of effective routes set to `invalid` are skipped.",
+			},
+			function.StringParameter{
+				Name:                "destination",
+				MarkdownDescription: "The destination IP address or CIDR",
+			},
+			function.StringParameter{
+				Name:                "mode",
+				MarkdownDescription: "The tie-break rules: `longest_prefix` (the default), `azure` or `aws`. Can be null.",
+				AllowNullValue:      true,
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: routeAttributeTypes,
+		},
+	}
+}
+
+// routeAttributeTypes are the attribute types of a route object.
+var routeAttributeTypes = map[string]attr.Type{
+	"prefix":        types.StringType,
+	"next_hop_type": types.StringType,
+	"next_hop":      types.StringType,
+	"source":        types.StringType,
+}
+
+// Run executes the route lookup function.
+func (f RouteLookupFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var routesArgument types.Dynamic
+	var destination string
+	var mode *string
+
+	// Parse the arguments
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &routesArgument, &destination, &mode))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input arguments
+	routes, err := parseRouteList(routesArgument, "routes", true)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error parsing routes: %s", err.Error())))
+		return
+	}
+	if len(routes) == 0 {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The routes argument must be provided and valid"))
+		return
+	}
+	if destination == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The destination argument must be provided and valid"))
+		return
+	}
+
+	// Look up the route
+	route, err := RouteLookup(routes, destination, stringValueOrEmpty(mode))
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error looking up route: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	if route == nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.ObjectNull(routeAttributeTypes)))
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, route))
+}
+
+// parseRouteList converts a list of route objects into routes.
+// The state attribute of effective routes is accepted and ignored, the source attribute is rejected when the argument sets the source.
+func parseRouteList(value types.Dynamic, path string, sourceAllowed bool) ([]Route, error) {
+	converted, err := dynamicToGo(value)
+	if err != nil || converted == nil {
+		return nil, err
+	}
+	list, err := goList(converted, path)
+	if err != nil {
+		return nil, err
+	}
+
+	routes := make([]Route, 0, len(list))
+	for i, item := range list {
+		itemPath := fmt.Sprintf("%s[%d]", path, i)
+		object, err := goObject(item, itemPath)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkObjectKeys(object, itemPath, "prefix", "next_hop_type", "next_hop", "source", "state"); err != nil {
+			return nil, err
+		}
+		if !sourceAllowed && object["source"] != nil {
+			return nil, fmt.Errorf("%s.source must not be set, the source of the routes is given by the %s argument", itemPath, path)
+		}
+
+		var route Route
+		if route.Prefix, err = goString(object["prefix"], itemPath+".prefix"); err != nil {
+			return nil, err
+		}
+		if route.NextHopType, err = goString(object["next_hop_type"], itemPath+".next_hop_type"); err != nil {
+			return nil, err
+		}
+		if object["next_hop"] != nil {
+			nextHop, err := goString(object["next_hop"], itemPath+".next_hop")
+			if err != nil {
+				return nil, err
+			}
+			route.NextHop = &nextHop
+		}
+		if object["source"] != nil {
+			if route.Source, err = goString(object["source"], itemPath+".source"); err != nil {
+				return nil, err
+			}
+		}
+
+		// Effective routes another route of the same prefix takes precedence over are skipped
+		if object["state"] != nil {
+			state, err := goString(object["state"], itemPath+".state")
+			if err != nil {
+				return nil, err
+			}
+			if state != routeStateActive && state != routeStateInvalid {
+				return nil, fmt.Errorf("%s.state must be %s or %s", itemPath, routeStateActive, routeStateInvalid)
+			}
+			if state == routeStateInvalid {
+				continue
+			}
+		}
+		routes = append(routes, route)
+	}
+	return routes, nil
+}
@@ -0,0 +1,227 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// testRouteLookupRoutes is a hub-spoke route table with forced tunnelling to a network virtual appliance.
+const testRouteLookupRoutes = `[
+	{ prefix = "0.0.0.0/0", next_hop_type = "Internet", source = "system" },
+	{ prefix = "10.1.0.0/16", next_hop_type = "VnetLocal", source = "system" },
+	{ prefix = "0.0.0.0/0", next_hop_type = "VirtualAppliance", next_hop = "10.0.0.4" },
+	{ prefix = "10.1.5.0/24", next_hop_type = "VirtualNetworkGateway", next_hop = "10.0.0.68", source = "bgp" },
+	{ prefix = "192.168.0.0/16", next_hop_type = "VirtualNetworkGateway", next_hop = "10.0.0.68", source = "bgp" },
+]`
+
+func TestRouteLookupFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		destination string
+		mode        string
+		route       string
+	}{
+		"azure-user-route-wins": {
+			destination: "8.8.8.8",
+			mode:        `"azure"`,
+			route:       `{"next_hop":"10.0.0.4","next_hop_type":"VirtualAppliance","prefix":"0.0.0.0/0","source":"user"}`,
+		},
+		"aws-local-route-wins": {
+			destination: "8.8.8.8",
+			mode:        `"aws"`,
+			route:       `{"next_hop":null,"next_hop_type":"Internet","prefix":"0.0.0.0/0","source":"system"}`,
+		},
+		"longest-prefix-first-route-wins": {
+			destination: "8.8.8.8",
+			mode:        `null`,
+			route:       `{"next_hop":null,"next_hop_type":"Internet","prefix":"0.0.0.0/0","source":"system"}`,
+		},
+		"azure-vnet-route-over-bgp": {
+			destination: "10.1.5.10",
+			mode:        `"azure"`,
+			route:       `{"next_hop":null,"next_hop_type":"VnetLocal","prefix":"10.1.0.0/16","source":"system"}`,
+		},
+		"longest-prefix-bgp": {
+			destination: "10.1.5.10",
+			mode:        `"longest_prefix"`,
+			route:       `{"next_hop":"10.0.0.68","next_hop_type":"VirtualNetworkGateway","prefix":"10.1.5.0/24","source":"bgp"}`,
+		},
+		"azure-bgp-route": {
+			destination: "192.168.10.1",
+			mode:        `"azure"`,
+			route:       `{"next_hop":"10.0.0.68","next_hop_type":"VirtualNetworkGateway","prefix":"192.168.0.0/16","source":"bgp"}`,
+		},
+		"cidr-destination": {
+			destination: "10.1.0.0/24",
+			mode:        `"azure"`,
+			route:       `{"next_hop":null,"next_hop_type":"VnetLocal","prefix":"10.1.0.0/16","source":"system"}`,
+		},
+		"no-route": {
+			destination: "2001:db8::1",
+			mode:        `"azure"`,
+			route:       `null`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::route_lookup(%s, %q, %s))
+							}
+						`, testRouteLookupRoutes, testCase.destination, testCase.mode),
+						Check: resource.TestCheckOutput("result", testCase.route),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestRouteLookupFunction_EffectiveRoutes(t *testing.T) {
+	testCases := map[string]struct {
+		destination string
+		mode        string
+		lookupMode  string
+		route       string
+	}{
+		"azure-user-route-wins": {
+			destination: "8.8.8.8",
+			mode:        `"azure"`,
+			lookupMode:  `"azure"`,
+			route:       `{"next_hop":"10.0.0.4","next_hop_type":"VirtualAppliance","prefix":"0.0.0.0/0","source":"user"}`,
+		},
+		"aws-local-route-wins": {
+			destination: "8.8.8.8",
+			mode:        `"aws"`,
+			lookupMode:  `"aws"`,
+			route:       `{"next_hop":null,"next_hop_type":"Internet","prefix":"0.0.0.0/0","source":"system"}`,
+		},
+		"invalid-route-skipped": {
+			destination: "8.8.8.8",
+			mode:        `"azure"`,
+			lookupMode:  `"aws"`,
+			route:       `{"next_hop":"10.0.0.4","next_hop_type":"VirtualAppliance","prefix":"0.0.0.0/0","source":"user"}`,
+		},
+		"bgp-route": {
+			destination: "192.168.10.1",
+			mode:        `"azure"`,
+			lookupMode:  `"azure"`,
+			route:       `{"next_hop":"10.0.0.68","next_hop_type":"VirtualNetworkGateway","prefix":"192.168.0.0/16","source":"bgp"}`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							locals {
+								effective = provider::iactools::route_table_effective(
+									[{ prefix = "0.0.0.0/0", next_hop_type = "Internet" }, { prefix = "10.1.0.0/16", next_hop_type = "VnetLocal" }],
+									[{ prefix = "0.0.0.0/0", next_hop_type = "VirtualAppliance", next_hop = "10.0.0.4" }],
+									[{ prefix = "192.168.0.0/16", next_hop_type = "VirtualNetworkGateway", next_hop = "10.0.0.68" }],
+									%[2]s,
+								)
+							}
+
+							output "result" {
+								value = jsonencode(provider::iactools::route_lookup(local.effective, %[1]q, %[3]s))
+							}
+						`, testCase.destination, testCase.mode, testCase.lookupMode),
+						Check: resource.TestCheckOutput("result", testCase.route),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestRouteLookupFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		routes      string
+		destination string
+		mode        string
+		error       string
+	}{
+		"empty-routes": {
+			routes:      `[]`,
+			destination: "10.0.0.1",
+			mode:        `null`,
+			error:       `(?s)Call to function "provider::iactools::route_lookup" failed.*routes\s+argument\s+must\s+be\s+provided\s+and\s+valid`,
+		},
+		"invalid-destination": {
+			routes:      `[{ prefix = "0.0.0.0/0", next_hop_type = "Internet" }]`,
+			destination: "10.0.0.300",
+			mode:        `null`,
+			error:       `(?s)Call to function "provider::iactools::route_lookup" failed.*invalid\s+IP\s+address:\s+10.0.0.300`,
+		},
+		"invalid-prefix": {
+			routes:      `[{ prefix = "10.0.0.0/33", next_hop_type = "Internet" }]`,
+			destination: "10.0.0.1",
+			mode:        `null`,
+			error:       `(?s)Call to function "provider::iactools::route_lookup" failed.*invalid\s+CIDR`,
+		},
+		"missing-next-hop-type": {
+			routes:      `[{ prefix = "10.0.0.0/8" }]`,
+			destination: "10.0.0.1",
+			mode:        `null`,
+			error:       `(?s)Call to function "provider::iactools::route_lookup" failed.*routes\[0\].next_hop_type\s+must\s+be\s+a\s+string`,
+		},
+		"invalid-state": {
+			routes:      `[{ prefix = "10.0.0.0/8", next_hop_type = "Internet", state = "stale" }]`,
+			destination: "10.0.0.1",
+			mode:        `null`,
+			error:       `(?s)Call to function "provider::iactools::route_lookup" failed.*routes\[0\]\.state\s+must\s+be\s+active\s+or\s+invalid`,
+		},
+		"invalid-source": {
+			routes:      `[{ prefix = "10.0.0.0/8", next_hop_type = "Internet", source = "ospf" }]`,
+			destination: "10.0.0.1",
+			mode:        `null`,
+			error:       `(?s)Call to function "provider::iactools::route_lookup" failed.*invalid\s+source\s+"ospf"`,
+		},
+		"invalid-mode": {
+			routes:      `[{ prefix = "10.0.0.0/8", next_hop_type = "Internet" }]`,
+			destination: "10.0.0.1",
+			mode:        `"gcp"`,
+			error:       `(?s)Call to function "provider::iactools::route_lookup" failed.*unknown\s+mode\s+"gcp"`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::route_lookup(%s, %q, %s)
+							}
+						`, testCase.routes, testCase.destination, testCase.mode),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
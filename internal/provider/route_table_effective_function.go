@@ -0,0 +1,122 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = RouteTableEffectiveFunction{}
+)
+
+// NewRouteTableEffectiveFunction is a helper function to create a new instance of RouteTableEffectiveFunction.
+func NewRouteTableEffectiveFunction() function.Function {
+	return RouteTableEffectiveFunction{}
+}
+
+// RouteTableEffectiveFunction is the struct for the route table effective function.
+type RouteTableEffectiveFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f RouteTableEffectiveFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "route_table_effective"
+}
+
+// Definition sets the definition for the function.
+func (f RouteTableEffectiveFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Compute the effective routes of system, user and BGP routes",
+		MarkdownDescription: "Combines the routes into an effective route table ordered by prefix, like the effective routes of an Azure network interface. " +
+			"Of the routes with the same prefix the preferred one is `active` and the others are `invalid`. " +
+			"The `azure` mode (the default) prefers user over BGP over system routes, except the system routes of the virtual network and its peerings, " +
+			"like `VnetLocal` and `VNetPeering`, which BGP routes can't override: BGP routes with the same or a more specific prefix are `invalid`. The `aws` mode prefers system (local) over user (static) over BGP (propagated) routes. " +
+			"The default mode is `azure`, unlike `route_lookup` which defaults to `longest_prefix`. " +
+			"Pass the output to `route_lookup` with the same mode to find the route of a destination, `route_lookup` skips the `invalid` routes.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name: "system_routes",
+				MarkdownDescription: "The list of system routes, each an object with `prefix`, `next_hop_type` and an optional `next_hop`. " +
+					"The source of a route is given by the argument it is passed in, routes with a `source` attribute are rejected. Can be null.",
+				AllowNullValue: true,
+			},
+			function.DynamicParameter{
+				Name:                "user_routes",
+				MarkdownDescription: "The list of user defined routes, in the same form as the system routes. Can be null.",
+				AllowNullValue:      true,
+			},
+			function.DynamicParameter{
+				Name:                "bgp_routes",
+				MarkdownDescription: "The list of routes learned over BGP, in the same form as the system routes. Can be null.",
+				AllowNullValue:      true,
+			},
+			function.StringParameter{
+				Name:                "mode",
+				MarkdownDescription: "The tie-break rules: `azure` (the default), `aws` or `longest_prefix`. Can be null.",
+				AllowNullValue:      true,
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"prefix":        types.StringType,
+					"next_hop_type": types.StringType,
+					"next_hop":      types.StringType,
+					"source":        types.StringType,
+					"state":         types.StringType,
+				},
+			},
+		},
+	}
+}
+
+// Run executes the route table effective function.
+func (f RouteTableEffectiveFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var systemArgument, userArgument, bgpArgument types.Dynamic
+	var mode *string
+
+	// Parse the arguments
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &systemArgument, &userArgument, &bgpArgument, &mode))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input arguments
+	systemRoutes, err := parseRouteList(systemArgument, "system_routes", false)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error parsing routes: %s", err.Error())))
+		return
+	}
+	userRoutes, err := parseRouteList(userArgument, "user_routes", false)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error parsing routes: %s", err.Error())))
+		return
+	}
+	bgpRoutes, err := parseRouteList(bgpArgument, "bgp_routes", false)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error parsing routes: %s", err.Error())))
+		return
+	}
+	if len(systemRoutes)+len(userRoutes)+len(bgpRoutes) == 0 {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("At least one of the system_routes, user_routes or bgp_routes arguments must contain routes"))
+		return
+	}
+
+	// Compute the effective routes
+	routes, err := RouteTableEffective(systemRoutes, userRoutes, bgpRoutes, stringValueOrEmpty(mode))
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error computing effective routes: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, routes))
+}
@@ -0,0 +1,139 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestRouteTableEffectiveFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		routes    string
+	}{
+		"azure-forced-tunnelling": {
+			arguments: `[
+				{ prefix = "10.1.0.0/16", next_hop_type = "VnetLocal" },
+				{ prefix = "0.0.0.0/0", next_hop_type = "Internet" },
+			], [
+				{ prefix = "0.0.0.0/0", next_hop_type = "VirtualAppliance", next_hop = "10.0.0.4" },
+			], [
+				{ prefix = "192.168.0.0/16", next_hop_type = "VirtualNetworkGateway", next_hop = "10.0.0.68" },
+			], null`,
+			routes: `["0.0.0.0/0:user:VirtualAppliance:active","0.0.0.0/0:system:Internet:invalid","10.1.0.0/16:system:VnetLocal:active","192.168.0.0/16:bgp:VirtualNetworkGateway:active"]`,
+		},
+		"azure-vnet-local-over-bgp": {
+			arguments: `[
+				{ prefix = "10.1.0.0/16", next_hop_type = "VnetLocal" },
+				{ prefix = "10.2.0.0/16", next_hop_type = "VNetPeering" },
+			], null, [
+				{ prefix = "10.1.0.0/16", next_hop_type = "VirtualNetworkGateway", next_hop = "10.0.0.68" },
+				{ prefix = "10.2.0.0/16", next_hop_type = "VirtualNetworkGateway", next_hop = "10.0.0.68" },
+				{ prefix = "10.2.1.0/24", next_hop_type = "VirtualNetworkGateway", next_hop = "10.0.0.68" },
+			], null`,
+			routes: `["10.1.0.0/16:system:VnetLocal:active","10.1.0.0/16:bgp:VirtualNetworkGateway:invalid","10.2.0.0/16:system:VNetPeering:active","10.2.0.0/16:bgp:VirtualNetworkGateway:invalid","10.2.1.0/24:bgp:VirtualNetworkGateway:invalid"]`,
+		},
+		"azure-user-route-over-vnet-local": {
+			arguments: `[
+				{ prefix = "10.1.0.0/16", next_hop_type = "VnetLocal" },
+			], [
+				{ prefix = "10.1.0.0/16", next_hop_type = "VirtualAppliance", next_hop = "10.0.0.4" },
+			], null, null`,
+			routes: `["10.1.0.0/16:user:VirtualAppliance:active","10.1.0.0/16:system:VnetLocal:invalid"]`,
+		},
+		"aws-bgp-same-prefix": {
+			arguments: `[
+				{ prefix = "10.1.0.0/16", next_hop_type = "local" },
+			], null, [
+				{ prefix = "10.1.0.0/16", next_hop_type = "vgw", next_hop = "vgw-0123456789abcdef0" },
+			], "aws"`,
+			routes: `["10.1.0.0/16:system:local:active","10.1.0.0/16:bgp:vgw:invalid"]`,
+		},
+		"aws-local-route": {
+			arguments: `[
+				{ prefix = "10.1.0.0/16", next_hop_type = "local" },
+			], [
+				{ prefix = "10.1.0.0/16", next_hop_type = "eni", next_hop = "eni-0123456789abcdef0" },
+				{ prefix = "0.0.0.0/0", next_hop_type = "nat", next_hop = "nat-0123456789abcdef0" },
+			], null, "aws"`,
+			routes: `["0.0.0.0/0:user:nat:active","10.1.0.0/16:system:local:active","10.1.0.0/16:user:eni:invalid"]`,
+		},
+		"canonical-prefixes": {
+			arguments: `null, [
+				{ prefix = "10.1.2.3/16", next_hop_type = "VirtualAppliance", next_hop = "10.0.0.4" },
+			], null, null`,
+			routes: `["10.1.0.0/16:user:VirtualAppliance:active"]`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode([for route in provider::iactools::route_table_effective(%s) : "${route.prefix}:${route.source}:${route.next_hop_type}:${route.state}"])
+							}
+						`, testCase.arguments),
+						Check: resource.TestCheckOutput("result", testCase.routes),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestRouteTableEffectiveFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		error     string
+	}{
+		"no-routes": {
+			arguments: `null, [], null, null`,
+			error:     `(?s)Call to function "provider::iactools::route_table_effective" failed.*At\s+least\s+one\s+of\s+the\s+system_routes`,
+		},
+		"invalid-user-route": {
+			arguments: `null, [{ prefix = "10.0.0.0", next_hop_type = "None" }], null, null`,
+			error:     `(?s)Call to function "provider::iactools::route_table_effective" failed.*invalid\s+CIDR`,
+		},
+		"source-set": {
+			arguments: `null, [{ prefix = "10.0.0.0/8", next_hop_type = "None", source = "bgp" }], null, null`,
+			error:     `(?s)Call to function "provider::iactools::route_table_effective" failed.*user_routes\[0\].source\s+must\s+not\s+be\s+set`,
+		},
+		"not-a-list": {
+			arguments: `null, null, { prefix = "10.0.0.0/8" }, null`,
+			error:     `(?s)Call to function "provider::iactools::route_table_effective" failed.*bgp_routes\s+must\s+be\s+a\s+list`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::route_table_effective(%s)
+							}
+						`, testCase.arguments),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
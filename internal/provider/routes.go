@@ -0,0 +1,280 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Route sources.
+const (
+	routeSourceSystem = "system"
+	routeSourceUser   = "user"
+	routeSourceBGP    = "bgp"
+)
+
+// Route selection modes.
+const (
+	routeModeLongestPrefix = "longest_prefix"
+	routeModeAzure         = "azure"
+	routeModeAWS           = "aws"
+)
+
+// Effective route states.
+const (
+	routeStateActive  = "active"
+	routeStateInvalid = "invalid"
+)
+
+// routeSourceAliases maps the route source names of the cloud platforms to the route sources.
+var routeSourceAliases = map[string]string{
+	"system":     routeSourceSystem,
+	"default":    routeSourceSystem,
+	"local":      routeSourceSystem,
+	"user":       routeSourceUser,
+	"static":     routeSourceUser,
+	"bgp":        routeSourceBGP,
+	"propagated": routeSourceBGP,
+}
+
+// routeRankVirtualNetwork is the rank key of the Azure system routes of the virtual network and its peerings, ranked apart from the other system routes.
+const routeRankVirtualNetwork = "virtual_network"
+
+// routeSourceRanks holds the preference of the route sources per mode, when routes have the same prefix length.
+// Azure prefers user defined routes over the system routes of the virtual network over BGP routes over the other system routes,
+// AWS prefers the local route over static routes over propagated routes.
+var routeSourceRanks = map[string]map[string]int{
+	routeModeLongestPrefix: {routeSourceSystem: 0, routeSourceUser: 0, routeSourceBGP: 0},
+	routeModeAzure:         {routeSourceUser: 0, routeRankVirtualNetwork: 1, routeSourceBGP: 2, routeSourceSystem: 3},
+	routeModeAWS:           {routeSourceSystem: 0, routeSourceUser: 1, routeSourceBGP: 2},
+}
+
+// azureVirtualNetworkNextHopTypes are the next hop types of the Azure system routes that BGP routes can't override, even when more specific.
+var azureVirtualNetworkNextHopTypes = []string{"vnetlocal", "virtualnetwork", "vnetpeering", "virtualnetworkpeering", "virtualnetworkserviceendpoint"}
+
+// Route holds a route of a route table.
+type Route struct {
+	Prefix      string  `tfsdk:"prefix"`
+	NextHopType string  `tfsdk:"next_hop_type"`
+	NextHop     *string `tfsdk:"next_hop"`
+	Source      string  `tfsdk:"source"`
+}
+
+// EffectiveRoute holds a route of an effective route table with its state.
+type EffectiveRoute struct {
+	Prefix      string  `tfsdk:"prefix"`
+	NextHopType string  `tfsdk:"next_hop_type"`
+	NextHop     *string `tfsdk:"next_hop"`
+	Source      string  `tfsdk:"source"`
+	State       string  `tfsdk:"state"`
+}
+
+// parsedRoute is a route with its parsed prefix and position in the route list.
+type parsedRoute struct {
+	route Route
+	ipnet *net.IPNet
+	index int
+}
+
+// RouteLookup selects the route of a destination address or CIDR, using longest prefix match and the tie-break rules of the mode.
+// It returns nil when no route matches the destination.
+func RouteLookup(routes []Route, destination, mode string) (*Route, error) {
+	ranks, err := routeModeRanks(mode)
+	if err != nil {
+		return nil, err
+	}
+	target, err := parseRouteDestination(destination)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := parseRoutes(routes)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []parsedRoute
+	var virtualNetworkRoute bool
+	for _, route := range parsed {
+		if !ipNetContains(route.ipnet, target) {
+			continue
+		}
+		candidates = append(candidates, route)
+		if isAzureVirtualNetworkRoute(route.route) {
+			virtualNetworkRoute = true
+		}
+	}
+
+	var best *parsedRoute
+	for i, candidate := range candidates {
+		// Azure system routes of the virtual network and its peerings are preferred over BGP routes
+		if mode == routeModeAzure && virtualNetworkRoute && candidate.route.Source == routeSourceBGP {
+			continue
+		}
+		if best == nil || compareRoutes(candidate, *best, ranks) < 0 {
+			best = &candidates[i]
+		}
+	}
+	if best == nil {
+		return nil, nil
+	}
+	return &best.route, nil
+}
+
+// RouteTableEffective combines system, user and BGP routes into the effective route table.
+// Of the routes with the same prefix the preferred one by the tie-break rules of the mode is active, the others are invalid.
+// In the azure mode BGP routes within the prefix of a system route of the virtual network or its peerings are invalid too.
+func RouteTableEffective(systemRoutes, userRoutes, bgpRoutes []Route, mode string) ([]EffectiveRoute, error) {
+	if mode == "" {
+		mode = routeModeAzure
+	}
+	ranks, err := routeModeRanks(mode)
+	if err != nil {
+		return nil, err
+	}
+
+	var routes []Route
+	for _, source := range []struct {
+		name   string
+		routes []Route
+	}{
+		{routeSourceSystem, systemRoutes},
+		{routeSourceUser, userRoutes},
+		{routeSourceBGP, bgpRoutes},
+	} {
+		for _, route := range source.routes {
+			route.Source = source.name
+			routes = append(routes, route)
+		}
+	}
+	parsed, err := parseRoutes(routes)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(parsed, func(i, j int) bool {
+		if c := compareIPNets(parsed[i].ipnet, parsed[j].ipnet); c != 0 {
+			return c < 0
+		}
+		return compareRoutes(parsed[i], parsed[j], ranks) < 0
+	})
+
+	var virtualNetworkRoutes []*net.IPNet
+	if mode == routeModeAzure {
+		for _, route := range parsed {
+			if isAzureVirtualNetworkRoute(route.route) {
+				virtualNetworkRoutes = append(virtualNetworkRoutes, route.ipnet)
+			}
+		}
+	}
+
+	effective := make([]EffectiveRoute, 0, len(parsed))
+	for i, route := range parsed {
+		state := routeStateActive
+		if i > 0 && parsed[i-1].ipnet.String() == route.ipnet.String() {
+			state = routeStateInvalid
+		}
+		if route.route.Source == routeSourceBGP && slices.ContainsFunc(virtualNetworkRoutes, func(ipnet *net.IPNet) bool { return ipNetContains(ipnet, route.ipnet) }) {
+			state = routeStateInvalid
+		}
+		effective = append(effective, EffectiveRoute{
+			Prefix:      route.ipnet.String(),
+			NextHopType: route.route.NextHopType,
+			NextHop:     route.route.NextHop,
+			Source:      route.route.Source,
+			State:       state,
+		})
+	}
+	return effective, nil
+}
+
+// Helper functions
+
+// routeModeRanks returns the source ranks of a route selection mode.
+func routeModeRanks(mode string) (map[string]int, error) {
+	if mode == "" {
+		mode = routeModeLongestPrefix
+	}
+	ranks, ok := routeSourceRanks[mode]
+	if !ok {
+		return nil, fmt.Errorf("unknown mode %q, must be one of %s, %s or %s", mode, routeModeLongestPrefix, routeModeAzure, routeModeAWS)
+	}
+	return ranks, nil
+}
+
+// parseRoutes validates the routes, normalizes their sources and parses their prefixes.
+func parseRoutes(routes []Route) ([]parsedRoute, error) {
+	parsed := make([]parsedRoute, 0, len(routes))
+	for i, route := range routes {
+		_, ipnet, err := net.ParseCIDR(route.Prefix)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR: %v", err)
+		}
+		if route.NextHopType == "" {
+			return nil, fmt.Errorf("route %s must have a next hop type", route.Prefix)
+		}
+
+		source := routeSourceUser
+		if route.Source != "" {
+			var ok bool
+			if source, ok = routeSourceAliases[strings.ToLower(route.Source)]; !ok {
+				return nil, fmt.Errorf("invalid source %q of route %s: must be system, user or bgp", route.Source, route.Prefix)
+			}
+		}
+		route.Prefix = ipnet.String()
+		route.Source = source
+		parsed = append(parsed, parsedRoute{route: route, ipnet: ipnet, index: i})
+	}
+	return parsed, nil
+}
+
+// parseRouteDestination parses a destination address as a host network, or a destination CIDR.
+func parseRouteDestination(destination string) (*net.IPNet, error) {
+	if strings.Contains(destination, "/") {
+		_, ipnet, err := net.ParseCIDR(destination)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR: %v", err)
+		}
+		return ipnet, nil
+	}
+
+	ip := net.ParseIP(destination)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid IP address: %s", destination)
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(8*len(ip), 8*len(ip))}, nil
+}
+
+// isAzureVirtualNetworkRoute reports whether a route is an Azure system route of the virtual network or its peerings.
+func isAzureVirtualNetworkRoute(route Route) bool {
+	return route.Source == routeSourceSystem && slices.Contains(azureVirtualNetworkNextHopTypes, strings.ToLower(route.NextHopType))
+}
+
+// routeRank returns the rank of the source of a route in a mode.
+func routeRank(route Route, ranks map[string]int) int {
+	if rank, ok := ranks[routeRankVirtualNetwork]; ok && isAzureVirtualNetworkRoute(route) {
+		return rank
+	}
+	return ranks[route.Source]
+}
+
+// compareRoutes orders routes by descending prefix length, then by the rank of their source and their position in the route list.
+func compareRoutes(a, b parsedRoute, ranks map[string]int) int {
+	aOnes, _ := a.ipnet.Mask.Size()
+	bOnes, _ := b.ipnet.Mask.Size()
+	if aOnes != bOnes {
+		return bOnes - aOnes
+	}
+	if c := routeRank(a.route, ranks) - routeRank(b.route, ranks); c != 0 {
+		return c
+	}
+	return a.index - b.index
+}
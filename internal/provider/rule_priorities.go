@@ -0,0 +1,260 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// rulePriorityRange holds the lowest and highest priority of a platform.
+type rulePriorityRange struct {
+	Start int64
+	End   int64
+}
+
+// rulePriorityPlatforms maps the supported platforms to their priority ranges.
+var rulePriorityPlatforms = map[string]rulePriorityRange{
+	"azure_nsg":      {Start: 100, End: 4096},
+	"azure_firewall": {Start: 100, End: 65000},
+	"aws_nacl":       {Start: 1, End: 32766},
+	"gcp_firewall":   {Start: 0, End: 65535},
+}
+
+// Default rule priority options.
+const (
+	rulePriorityDefaultPlatform = "azure_nsg"
+	rulePriorityDefaultSpacing  = 10
+)
+
+// RulePriorityOptions holds the options of the rule priority allocation.
+type RulePriorityOptions struct {
+	Start     int64
+	End       int64
+	Spacing   int64
+	GroupSize int64
+	Existing  map[string]int64
+}
+
+// RulePriorityGroup holds an ordered group of rules.
+type RulePriorityGroup struct {
+	Name  string
+	Rules []RulePriorityRule
+}
+
+// RulePriorityRule holds the name and the optional pinned priority of a rule.
+type RulePriorityRule struct {
+	Name   string
+	Pinned *int64
+}
+
+// rulePrioritySlot is a rule in allocation order with its assigned priority.
+type rulePrioritySlot struct {
+	name     string
+	group    string
+	pinned   bool
+	priority *int64
+}
+
+// RulePriorities assigns a unique priority to every rule, keeping the order of the groups and the rules in them.
+// Pinned priorities are always used, existing priorities are kept when they still fit the order,
+// and new rules are placed into the gaps between them.
+func RulePriorities(groups []RulePriorityGroup, options RulePriorityOptions) (map[string]int64, error) {
+	if options.Start > options.End {
+		return nil, fmt.Errorf("start %d must not be greater than end %d", options.Start, options.End)
+	}
+	if options.Spacing < 1 {
+		return nil, fmt.Errorf("spacing must be at least 1, got %d", options.Spacing)
+	}
+	if options.GroupSize < 0 {
+		return nil, fmt.Errorf("group_size must not be negative, got %d", options.GroupSize)
+	}
+
+	// Flatten the groups into segments, one per group when the groups have fixed size blocks
+	var segments [][]*rulePrioritySlot
+	var bounds []rulePriorityRange
+	seen := make(map[string]string)
+	used := make(map[int64]string)
+	for i, group := range groups {
+		groupName := group.Name
+		if groupName == "" {
+			groupName = fmt.Sprintf("#%d", i)
+		}
+
+		if options.GroupSize > 0 || len(segments) == 0 {
+			segments = append(segments, nil)
+			if options.GroupSize > 0 {
+				start := options.Start + int64(i)*options.GroupSize
+				end := start + options.GroupSize - 1
+				if end > options.End {
+					return nil, fmt.Errorf("priority range %d-%d is exhausted: group %q needs the block %d-%d", options.Start, options.End, groupName, start, end)
+				}
+				bounds = append(bounds, rulePriorityRange{Start: start, End: end})
+			} else {
+				bounds = append(bounds, rulePriorityRange{Start: options.Start, End: options.End})
+			}
+		}
+		segment := len(segments) - 1
+
+		for _, rule := range group.Rules {
+			if rule.Name == "" {
+				return nil, fmt.Errorf("rule names must not be empty in group %q", groupName)
+			}
+			if other, ok := seen[rule.Name]; ok {
+				return nil, fmt.Errorf("duplicate rule name %q in groups %q and %q", rule.Name, other, groupName)
+			}
+			seen[rule.Name] = groupName
+
+			slot := &rulePrioritySlot{name: rule.Name, group: groupName}
+			if rule.Pinned != nil {
+				priority := *rule.Pinned
+				if priority < bounds[segment].Start || priority > bounds[segment].End {
+					return nil, fmt.Errorf("pinned priority %d of rule %q is outside of the range %d-%d", priority, rule.Name, bounds[segment].Start, bounds[segment].End)
+				}
+				if other, ok := used[priority]; ok {
+					return nil, fmt.Errorf("pinned priority %d of rule %q is already pinned by rule %q", priority, rule.Name, other)
+				}
+				used[priority] = rule.Name
+				slot.pinned = true
+				slot.priority = &priority
+			}
+			segments[segment] = append(segments[segment], slot)
+		}
+	}
+
+	priorities := make(map[string]int64, len(seen))
+	for i, slots := range segments {
+		if err := allocateRulePriorities(slots, bounds[i], options); err != nil {
+			return nil, err
+		}
+		for _, slot := range slots {
+			priorities[slot.name] = *slot.priority
+		}
+	}
+	return priorities, nil
+}
+
+// Helper functions
+
+// allocateRulePriorities assigns the priorities of the slots of a segment within its bounds.
+func allocateRulePriorities(slots []*rulePrioritySlot, bounds rulePriorityRange, options RulePriorityOptions) error {
+	// Pinned priorities must follow the order of the rules
+	var previous *rulePrioritySlot
+	for _, slot := range slots {
+		if !slot.pinned {
+			continue
+		}
+		if previous != nil && *slot.priority <= *previous.priority {
+			return fmt.Errorf("pinned priority %d of rule %q must be greater than pinned priority %d of preceding rule %q", *slot.priority, slot.name, *previous.priority, previous.name)
+		}
+		previous = slot
+	}
+
+	// Keep the existing priorities that still fit between the surrounding anchors
+	last := bounds.Start - 1
+	for i, slot := range slots {
+		if slot.pinned {
+			last = *slot.priority
+			continue
+		}
+		existing, ok := options.Existing[slot.name]
+		if !ok || existing <= last || existing > bounds.End {
+			continue
+		}
+		if next := nextPinnedPriority(slots[i+1:]); next != nil && existing >= *next {
+			continue
+		}
+		priority := existing
+		slot.priority = &priority
+		last = priority
+	}
+
+	// Place the remaining rules into the gaps between the anchors
+	for i := 0; i < len(slots); {
+		if slots[i].priority != nil {
+			i++
+			continue
+		}
+		j := i
+		for j < len(slots) && slots[j].priority == nil {
+			j++
+		}
+
+		var lower, upper *int64
+		if i > 0 {
+			lower = slots[i-1].priority
+		}
+		if j < len(slots) {
+			upper = slots[j].priority
+		}
+		values, err := rulePriorityGap(lower, upper, int64(j-i), bounds, options.Spacing)
+		if err != nil {
+			names := make([]string, 0, j-i)
+			for _, slot := range slots[i:j] {
+				names = append(names, slot.name)
+			}
+			return fmt.Errorf("priority range %d-%d is exhausted: %v, cannot place rules %s", bounds.Start, bounds.End, err, strings.Join(names, ", "))
+		}
+		for k, value := range values {
+			slots[i+k].priority = &value
+		}
+		i = j
+	}
+	return nil
+}
+
+// nextPinnedPriority returns the priority of the first pinned slot.
+func nextPinnedPriority(slots []*rulePrioritySlot) *int64 {
+	for _, slot := range slots {
+		if slot.pinned {
+			return slot.priority
+		}
+	}
+	return nil
+}
+
+// rulePriorityGap returns count increasing priorities between the lower and upper anchors.
+// The priorities follow the spacing when they fit, otherwise they are spread evenly across the gap.
+func rulePriorityGap(lower, upper *int64, count int64, bounds rulePriorityRange, spacing int64) ([]int64, error) {
+	first, last := bounds.Start, bounds.End
+	if lower != nil {
+		first = *lower + 1
+	}
+	if upper != nil {
+		last = *upper - 1
+	}
+	if last-first+1 < count {
+		return nil, fmt.Errorf("%d free priorities between %d and %d", max(last-first+1, 0), first, last)
+	}
+
+	values := make([]int64, count)
+	start := bounds.Start
+	if lower != nil {
+		start = *lower + spacing
+	}
+	if start+(count-1)*spacing <= last {
+		for k := range values {
+			values[k] = start + int64(k)*spacing
+		}
+		return values, nil
+	}
+
+	step := (last - first + 2) / (count + 1)
+	for k := range values {
+		values[k] = first - 1 + int64(k+1)*step
+	}
+	return values, nil
+}
+
+// rulePriorityPlatformNames returns the sorted names of the supported platforms.
+func rulePriorityPlatformNames() []string {
+	names := make([]string, 0, len(rulePriorityPlatforms))
+	for name := range rulePriorityPlatforms {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,232 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = RulePrioritiesFunction{}
+)
+
+// NewRulePrioritiesFunction is a helper function to create a new instance of RulePrioritiesFunction.
+func NewRulePrioritiesFunction() function.Function {
+	return RulePrioritiesFunction{}
+}
+
+// RulePrioritiesFunction is the struct for the rule priorities function.
+type RulePrioritiesFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f RulePrioritiesFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "rule_priorities"
+}
+
+// Definition sets the definition for the function.
+func (f RulePrioritiesFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Allocate unique priorities to ordered groups of firewall or NSG rules",
+		MarkdownDescription: "Outputs a map of rule names to priorities, following the order of the groups and of the rules in them. " +
+			"Each group is an object with an optional `name` and a list of `rules`, a rule is either its name or an object with `name` and an optional pinned `priority`. " +
+			"Pinned priorities are always used, priorities from the `existing` option are kept while they still fit the order, so rules added in the middle take the free numbers between their neighbours. " +
+			"Without `existing` the priorities are allocated from the start of the range on every call, so adding or removing a rule renumbers the rules after it, " +
+			"which replaces them on most platforms. Pass the previously allocated priorities, e.g. read from the deployed rules, as `existing` to keep them stable. " +
+			"The function fails when the priority range has no room left for the rules.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:                "rule_groups",
+				MarkdownDescription: "The ordered list of rule groups",
+			},
+			function.DynamicParameter{
+				Name: "options",
+				MarkdownDescription: "An object with the optional attributes `platform` (`azure_nsg`, `azure_firewall`, `aws_nacl` or `gcp_firewall`, defaults to `azure_nsg`), " +
+					"`start` and `end` to override the priority range of the platform, `spacing` between consecutive rules (defaults to 10), " +
+					"`group_size` to give each group a fixed block of priorities, and `existing`, a map of rule names to previously allocated priorities. Can be null.",
+				AllowNullValue: true,
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.Int64Type,
+		},
+	}
+}
+
+// Run executes the rule priorities function.
+func (f RulePrioritiesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ruleGroups, options types.Dynamic
+
+	// Parse the arguments
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &ruleGroups, &options))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input arguments
+	groups, err := parseRulePriorityGroups(ruleGroups)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error parsing rule groups: %s", err.Error())))
+		return
+	}
+	if len(groups) == 0 {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The rule_groups argument must be provided and valid"))
+		return
+	}
+	allocation, err := parseRulePriorityOptions(options)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error parsing options: %s", err.Error())))
+		return
+	}
+
+	// Allocate the priorities
+	priorities, err := RulePriorities(groups, allocation)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error allocating rule priorities: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, priorities))
+}
+
+// parseRulePriorityGroups converts the rule_groups argument into rule groups.
+func parseRulePriorityGroups(value types.Dynamic) ([]RulePriorityGroup, error) {
+	converted, err := dynamicToGo(value)
+	if err != nil {
+		return nil, err
+	}
+	if converted == nil {
+		return nil, nil
+	}
+	list, err := goList(converted, "rule_groups")
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]RulePriorityGroup, 0, len(list))
+	for i, item := range list {
+		path := fmt.Sprintf("rule_groups[%d]", i)
+		object, err := goObject(item, path)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkObjectKeys(object, path, "name", "rules"); err != nil {
+			return nil, err
+		}
+
+		var group RulePriorityGroup
+		if object["name"] != nil {
+			if group.Name, err = goString(object["name"], path+".name"); err != nil {
+				return nil, err
+			}
+		}
+		rules, err := goList(object["rules"], path+".rules")
+		if err != nil {
+			return nil, err
+		}
+		for j, rule := range rules {
+			rulePath := fmt.Sprintf("%s.rules[%d]", path, j)
+			if name, ok := rule.(string); ok {
+				group.Rules = append(group.Rules, RulePriorityRule{Name: name})
+				continue
+			}
+			ruleObject, err := goObject(rule, rulePath)
+			if err != nil {
+				return nil, fmt.Errorf("%s must be a rule name or an object", rulePath)
+			}
+			if err := checkObjectKeys(ruleObject, rulePath, "name", "priority"); err != nil {
+				return nil, err
+			}
+			var parsed RulePriorityRule
+			if parsed.Name, err = goString(ruleObject["name"], rulePath+".name"); err != nil {
+				return nil, err
+			}
+			if ruleObject["priority"] != nil {
+				priority, err := goInt64(ruleObject["priority"], rulePath+".priority")
+				if err != nil {
+					return nil, err
+				}
+				parsed.Pinned = &priority
+			}
+			group.Rules = append(group.Rules, parsed)
+		}
+		groups = append(groups, group)
+	}
+	return groups, nil
+}
+
+// parseRulePriorityOptions converts the options argument into allocation options with the platform defaults applied.
+func parseRulePriorityOptions(value types.Dynamic) (RulePriorityOptions, error) {
+	platform := rulePriorityPlatforms[rulePriorityDefaultPlatform]
+	options := RulePriorityOptions{
+		Start:   platform.Start,
+		End:     platform.End,
+		Spacing: rulePriorityDefaultSpacing,
+	}
+
+	converted, err := dynamicToGo(value)
+	if err != nil || converted == nil {
+		return options, err
+	}
+	object, err := goObject(converted, "options")
+	if err != nil {
+		return options, err
+	}
+	if err := checkObjectKeys(object, "options", "platform", "start", "end", "spacing", "group_size", "existing"); err != nil {
+		return options, err
+	}
+
+	if object["platform"] != nil {
+		name, err := goString(object["platform"], "options.platform")
+		if err != nil {
+			return options, err
+		}
+		platform, ok := rulePriorityPlatforms[strings.ToLower(name)]
+		if !ok {
+			return options, fmt.Errorf("unknown platform %q, available platforms: %s", name, strings.Join(rulePriorityPlatformNames(), ", "))
+		}
+		options.Start, options.End = platform.Start, platform.End
+	}
+
+	for _, field := range []struct {
+		key    string
+		target *int64
+	}{
+		{"start", &options.Start},
+		{"end", &options.End},
+		{"spacing", &options.Spacing},
+		{"group_size", &options.GroupSize},
+	} {
+		if object[field.key] == nil {
+			continue
+		}
+		if *field.target, err = goInt64(object[field.key], "options."+field.key); err != nil {
+			return options, err
+		}
+	}
+
+	if object["existing"] != nil {
+		existing, err := goObject(object["existing"], "options.existing")
+		if err != nil {
+			return options, err
+		}
+		options.Existing = make(map[string]int64, len(existing))
+		for name, priority := range existing {
+			if priority == nil {
+				continue
+			}
+			if options.Existing[name], err = goInt64(priority, fmt.Sprintf("options.existing[%q]", name)); err != nil {
+				return options, err
+			}
+		}
+	}
+	return options, nil
+}
@@ -0,0 +1,193 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestRulePrioritiesFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		ruleGroups string
+		options    string
+		priorities string
+	}{
+		"default-spacing": {
+			ruleGroups: `[{ name = "platform", rules = ["allow-bastion", "allow-lb"] }, { name = "app", rules = ["allow-http", "allow-https"] }]`,
+			options:    `null`,
+			priorities: `{"allow-bastion":100,"allow-http":120,"allow-https":130,"allow-lb":110}`,
+		},
+		"insert-in-the-middle": {
+			ruleGroups: `[{ rules = ["a", "b", "new", "c"] }]`,
+			options:    `{ existing = { a = 100, b = 110, c = 120 } }`,
+			priorities: `{"a":100,"b":110,"c":120,"new":115}`,
+		},
+		"pinned-priority": {
+			ruleGroups: `[{ rules = ["a", "b", { name = "deny-all", priority = 4096 }] }]`,
+			options:    `null`,
+			priorities: `{"a":100,"b":110,"deny-all":4096}`,
+		},
+		"group-size": {
+			ruleGroups: `[{ name = "first", rules = ["a", "b"] }, { name = "second", rules = ["c"] }]`,
+			options:    `{ group_size = 100 }`,
+			priorities: `{"a":100,"b":110,"c":200}`,
+		},
+		"aws-nacl": {
+			ruleGroups: `[{ rules = ["a", "b"] }]`,
+			options:    `{ platform = "aws_nacl", spacing = 100 }`,
+			priorities: `{"a":1,"b":101}`,
+		},
+		"compressed-spacing": {
+			ruleGroups: `[{ rules = ["a", "b", "c"] }]`,
+			options:    `{ start = 100, end = 103 }`,
+			priorities: `{"a":100,"b":101,"c":102}`,
+		},
+		"reordered-existing": {
+			ruleGroups: `[{ rules = ["b", "a"] }]`,
+			options:    `{ existing = { a = 100, b = 110 } }`,
+			priorities: `{"a":120,"b":110}`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::rule_priorities(%s, %s))
+							}
+						`, testCase.ruleGroups, testCase.options),
+						Check: resource.TestCheckOutput("result", testCase.priorities),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestRulePrioritiesFunction_Existing(t *testing.T) {
+	testCases := map[string]struct {
+		before     string
+		after      string
+		priorities string
+	}{
+		"insert-in-the-middle": {
+			before:     `[{ rules = ["a", "b", "c"] }]`,
+			after:      `[{ rules = ["a", "new", "b", "c"] }]`,
+			priorities: `{"a":100,"b":110,"c":120,"new":105}`,
+		},
+		"insert-at-the-end": {
+			before:     `[{ rules = ["a", "b"] }]`,
+			after:      `[{ rules = ["a", "b", "new"] }]`,
+			priorities: `{"a":100,"b":110,"new":120}`,
+		},
+		"insert-into-first-group": {
+			before:     `[{ name = "platform", rules = ["a"] }, { name = "app", rules = ["b"] }]`,
+			after:      `[{ name = "platform", rules = ["a", "new"] }, { name = "app", rules = ["b"] }]`,
+			priorities: `{"a":100,"b":110,"new":105}`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							locals {
+								before = provider::iactools::rule_priorities(%s, null)
+								after  = provider::iactools::rule_priorities(%s, { existing = local.before })
+							}
+
+							output "result" {
+								value = jsonencode(local.after)
+							}
+
+							output "unchanged" {
+								value = alltrue([for name, priority in local.before : local.after[name] == priority])
+							}
+						`, testCase.before, testCase.after),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckOutput("result", testCase.priorities),
+							resource.TestCheckOutput("unchanged", "true"),
+						),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestRulePrioritiesFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		ruleGroups string
+		options    string
+		error      string
+	}{
+		"empty-rule-groups": {
+			ruleGroups: `[]`,
+			options:    `null`,
+			error:      `(?s)Call to function "provider::iactools::rule_priorities" failed.*rule_groups argument must be provided and valid`,
+		},
+		"range-exhausted": {
+			ruleGroups: `[{ rules = ["a", "b", "c"] }]`,
+			options:    `{ start = 100, end = 101 }`,
+			error:      `(?s)Call to function "provider::iactools::rule_priorities" failed.*priority range 100-101 is.*exhausted`,
+		},
+		"duplicate-rule": {
+			ruleGroups: `[{ name = "first", rules = ["a"] }, { name = "second", rules = ["a"] }]`,
+			options:    `null`,
+			error:      `(?s)Call to function "provider::iactools::rule_priorities" failed.*duplicate rule name "a"`,
+		},
+		"pinned-out-of-order": {
+			ruleGroups: `[{ rules = [{ name = "a", priority = 200 }, { name = "b", priority = 150 }] }]`,
+			options:    `null`,
+			error:      `(?s)Call to function "provider::iactools::rule_priorities" failed.*pinned priority 150 of rule "b" must.*be.*greater`,
+		},
+		"pinned-out-of-range": {
+			ruleGroups: `[{ rules = [{ name = "a", priority = 5000 }] }]`,
+			options:    `null`,
+			error:      `(?s)Call to function "provider::iactools::rule_priorities" failed.*outside of.*the range 100-4096`,
+		},
+		"unknown-platform": {
+			ruleGroups: `[{ rules = ["a"] }]`,
+			options:    `{ platform = "unknown" }`,
+			error:      `(?s)Call to function "provider::iactools::rule_priorities" failed.*unknown platform "unknown"`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::rule_priorities(%s, %s)
+							}
+						`, testCase.ruleGroups, testCase.options),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,423 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Security rule finding types.
+const (
+	securityRuleShadowed          = "shadowed"
+	securityRuleRedundant         = "redundant"
+	securityRuleConflict          = "conflict"
+	securityRuleDuplicatePriority = "duplicate_priority"
+)
+
+// securityRuleActionVerbs maps the rule actions to the verbs used in the finding messages.
+var securityRuleActionVerbs = map[string]string{
+	"allow": "allows",
+	"deny":  "denies",
+}
+
+// securityRuleProtocols maps the protocol names and IANA protocol numbers used by the platforms to protocol names.
+// Other protocol numbers are compared as numbers.
+var securityRuleProtocols = map[string]string{
+	"*": "*", "any": "*", "all": "*", "-1": "*",
+	"tcp": "tcp", "6": "tcp",
+	"udp": "udp", "17": "udp",
+	"sctp": "sctp", "132": "sctp",
+	"icmp": "icmp", "1": "icmp",
+	"icmpv6": "icmpv6", "icmp6": "icmpv6", "58": "icmpv6",
+	"esp": "esp", "50": "esp",
+	"ah": "ah", "51": "ah",
+	"gre": "gre", "47": "gre",
+	"ipip": "ipip", "4": "ipip",
+}
+
+// securityRulePortProtocols are the protocols whose rules can restrict ports, * includes them.
+var securityRulePortProtocols = []string{"*", "tcp", "udp", "sctp"}
+
+// SecurityRule holds the match criteria of a security rule.
+type SecurityRule struct {
+	Name                string
+	Priority            int64
+	Direction           string
+	Action              string
+	Protocol            string
+	SourcePrefixes      []string
+	SourcePorts         []string
+	DestinationPrefixes []string
+	DestinationPorts    []string
+}
+
+// SecurityRuleFinding describes a problem between two security rules.
+type SecurityRuleFinding struct {
+	Type            string `tfsdk:"type"`
+	Direction       string `tfsdk:"direction"`
+	Rule            string `tfsdk:"rule"`
+	Priority        int64  `tfsdk:"priority"`
+	RelatedRule     string `tfsdk:"related_rule"`
+	RelatedPriority int64  `tfsdk:"related_priority"`
+	Message         string `tfsdk:"message"`
+}
+
+// securityRuleMatch is the parsed match space of a security rule.
+type securityRuleMatch struct {
+	rule                SecurityRule
+	protocol            string
+	sourcePrefixes      prefixSet
+	sourcePorts         []IntRange
+	destinationPrefixes prefixSet
+	destinationPorts    []IntRange
+}
+
+// prefixSet is a set of address prefixes and service tags.
+type prefixSet struct {
+	any    bool
+	ipnets []*net.IPNet
+	tags   []string
+}
+
+// SecurityRulesAnalyze reports the rules that are shadowed by or redundant with a rule of higher priority,
+// or with the union of the destination ports of several rules of higher priority,
+// and the rules that partially overlap a rule of higher priority with the opposite action.
+// Rules are evaluated in ascending priority order within each direction.
+func SecurityRulesAnalyze(rules []SecurityRule) ([]SecurityRuleFinding, error) {
+	matches := make([]securityRuleMatch, 0, len(rules))
+	names := make(map[string]bool)
+	for _, rule := range rules {
+		if names[rule.Name] {
+			return nil, fmt.Errorf("duplicate rule name %q", rule.Name)
+		}
+		names[rule.Name] = true
+
+		match, err := parseSecurityRule(rule)
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, match)
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].rule.Direction != matches[j].rule.Direction {
+			return matches[i].rule.Direction < matches[j].rule.Direction
+		}
+		return matches[i].rule.Priority < matches[j].rule.Priority
+	})
+
+	findings := make([]SecurityRuleFinding, 0)
+	for i, lower := range matches {
+		for _, higher := range matches[:i] {
+			if higher.rule.Direction != lower.rule.Direction {
+				continue
+			}
+			if higher.rule.Priority == lower.rule.Priority {
+				findings = append(findings, newSecurityRuleFinding(securityRuleDuplicatePriority, lower, higher,
+					"has the same priority as rule %q", higher.rule.Name))
+			}
+		}
+
+		// A rule fully covered by a single rule of higher priority never matches
+		var covered bool
+		for _, higher := range matches[:i] {
+			if higher.rule.Direction != lower.rule.Direction || higher.rule.Priority == lower.rule.Priority || !higher.covers(lower) {
+				continue
+			}
+			if higher.rule.Action == lower.rule.Action {
+				findings = append(findings, newSecurityRuleFinding(securityRuleRedundant, lower, higher,
+					"is fully covered by rule %q with the same action", higher.rule.Name))
+			} else {
+				findings = append(findings, newSecurityRuleFinding(securityRuleShadowed, lower, higher,
+					"never matches, rule %q %s all of its traffic", higher.rule.Name, securityRuleActionVerbs[higher.rule.Action]))
+			}
+			covered = true
+			break
+		}
+		if covered {
+			continue
+		}
+
+		// A rule whose destination ports are covered by the union of several rules of higher priority never matches either
+		if finding, ok := securityRuleUnionFinding(lower, matches[:i]); ok {
+			findings = append(findings, finding)
+			continue
+		}
+
+		// A rule partially overlapping a rule of higher priority with the opposite action only applies to part of its traffic
+		for _, higher := range matches[:i] {
+			if higher.rule.Direction != lower.rule.Direction || higher.rule.Priority == lower.rule.Priority || higher.rule.Action == lower.rule.Action {
+				continue
+			}
+			if higher.overlaps(lower) {
+				findings = append(findings, newSecurityRuleFinding(securityRuleConflict, lower, higher,
+					"overlaps rule %q, which %s part of its traffic", higher.rule.Name, securityRuleActionVerbs[higher.rule.Action]))
+			}
+		}
+	}
+	return findings, nil
+}
+
+// Helper functions
+
+// parseSecurityRule validates a security rule and parses its match space.
+func parseSecurityRule(rule SecurityRule) (securityRuleMatch, error) {
+	var match securityRuleMatch
+	if rule.Name == "" {
+		return match, fmt.Errorf("rule names must not be empty")
+	}
+
+	switch strings.ToLower(rule.Direction) {
+	case "", "inbound", "ingress":
+		rule.Direction = "inbound"
+	case "outbound", "egress":
+		rule.Direction = "outbound"
+	default:
+		return match, fmt.Errorf("invalid direction %q of rule %q: must be inbound or outbound", rule.Direction, rule.Name)
+	}
+
+	switch strings.ToLower(rule.Action) {
+	case "allow", "accept":
+		rule.Action = "allow"
+	case "deny", "reject", "drop":
+		rule.Action = "deny"
+	default:
+		return match, fmt.Errorf("invalid action %q of rule %q: must be allow or deny", rule.Action, rule.Name)
+	}
+
+	match.rule = rule
+	protocol, err := normalizeSecurityRuleProtocol(rule.Protocol)
+	if err != nil {
+		return match, fmt.Errorf("invalid protocol %q of rule %q: %v", rule.Protocol, rule.Name, err)
+	}
+	match.protocol = protocol
+
+	if match.sourcePrefixes, err = parsePrefixSet(rule.SourcePrefixes); err != nil {
+		return match, fmt.Errorf("invalid source prefixes of rule %q: %v", rule.Name, err)
+	}
+	if match.destinationPrefixes, err = parsePrefixSet(rule.DestinationPrefixes); err != nil {
+		return match, fmt.Errorf("invalid destination prefixes of rule %q: %v", rule.Name, err)
+	}
+
+	// Ports only restrict the traffic of protocols that have ports
+	match.sourcePorts, match.destinationPorts = allPorts, allPorts
+	if slices.Contains(securityRulePortProtocols, match.protocol) {
+		if match.sourcePorts, err = parsePortRangeList(rule.SourcePorts); err != nil {
+			return match, fmt.Errorf("invalid source ports of rule %q: %v", rule.Name, err)
+		}
+		if match.destinationPorts, err = parsePortRangeList(rule.DestinationPorts); err != nil {
+			return match, fmt.Errorf("invalid destination ports of rule %q: %v", rule.Name, err)
+		}
+	}
+	return match, nil
+}
+
+// normalizeSecurityRuleProtocol converts a protocol name or IANA protocol number to the protocol name used to compare rules.
+func normalizeSecurityRuleProtocol(protocol string) (string, error) {
+	protocol = strings.ToLower(strings.TrimSpace(protocol))
+	if protocol == "" {
+		return "*", nil
+	}
+	if name, ok := securityRuleProtocols[protocol]; ok {
+		return name, nil
+	}
+	if number, err := strconv.Atoi(protocol); err == nil && number >= 0 && number <= 255 {
+		if name, ok := securityRuleProtocols[strconv.Itoa(number)]; ok {
+			return name, nil
+		}
+		return strconv.Itoa(number), nil
+	}
+	return "", fmt.Errorf("must be *, tcp, udp, sctp, icmp, icmpv6, esp, ah, gre, ipip or an IP protocol number from 0 to 255")
+}
+
+// parsePortRangeList parses and merges a list of port specifications, an empty list stands for every port.
+func parsePortRangeList(specs []string) ([]IntRange, error) {
+	if len(specs) == 0 {
+		return allPorts, nil
+	}
+	var ranges []IntRange
+	for _, spec := range specs {
+		parsed, err := parsePortRanges(spec)
+		if err != nil {
+			return nil, err
+		}
+		ranges = append(ranges, parsed...)
+	}
+	return normalizeIntRanges(ranges), nil
+}
+
+// parsePrefixSet parses address prefixes, addresses and service tags, an empty list stands for every address.
+func parsePrefixSet(prefixes []string) (prefixSet, error) {
+	var set prefixSet
+	if len(prefixes) == 0 {
+		set.any = true
+		return set, nil
+	}
+	for _, prefix := range prefixes {
+		prefix = strings.TrimSpace(prefix)
+		switch strings.ToLower(prefix) {
+		case "*", "any":
+			set.any = true
+			continue
+		}
+		if strings.Contains(prefix, "/") {
+			_, ipnet, err := net.ParseCIDR(prefix)
+			if err != nil {
+				return set, fmt.Errorf("invalid CIDR: %v", err)
+			}
+			set.ipnets = append(set.ipnets, ipnet)
+			continue
+		}
+		if ip := net.ParseIP(prefix); ip != nil {
+			if ip4 := ip.To4(); ip4 != nil {
+				ip = ip4
+			}
+			set.ipnets = append(set.ipnets, &net.IPNet{IP: ip, Mask: net.CIDRMask(8*len(ip), 8*len(ip))})
+			continue
+		}
+		if prefix == "" {
+			return set, fmt.Errorf("prefixes must not be empty")
+		}
+		set.tags = append(set.tags, strings.ToLower(prefix))
+	}
+	set.ipnets = aggregateCIDRs(set.ipnets)
+	return set, nil
+}
+
+// contains reports whether the prefix set contains every address of the other set.
+// Service tags only contain themselves, as their addresses are not known.
+func (s prefixSet) contains(other prefixSet) bool {
+	if s.any {
+		return true
+	}
+	if other.any {
+		return false
+	}
+	for _, tag := range other.tags {
+		found := false
+		for _, candidate := range s.tags {
+			if candidate == tag {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	for _, inner := range other.ipnets {
+		found := false
+		for _, outer := range s.ipnets {
+			if ipNetContains(outer, inner) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// overlaps reports whether the prefix sets share any address.
+// Service tags only overlap with themselves and with the wildcard.
+func (s prefixSet) overlaps(other prefixSet) bool {
+	if s.any || other.any {
+		return true
+	}
+	for _, tag := range s.tags {
+		for _, candidate := range other.tags {
+			if candidate == tag {
+				return true
+			}
+		}
+	}
+	for _, a := range s.ipnets {
+		for _, b := range other.ipnets {
+			if ipNetsOverlap(a, b) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// securityRuleUnionFinding reports whether the destination ports of a rule are covered by the union of the destination ports
+// of the rules of higher priority that cover the rest of its match space. The rule is redundant when all of these rules have
+// its action, and shadowed otherwise, related to the first rule with the opposite action.
+func securityRuleUnionFinding(lower securityRuleMatch, higherMatches []securityRuleMatch) (SecurityRuleFinding, bool) {
+	remaining := lower.destinationPorts
+	var related []securityRuleMatch
+	for _, higher := range higherMatches {
+		if higher.rule.Direction != lower.rule.Direction || higher.rule.Priority == lower.rule.Priority {
+			continue
+		}
+		if !(higher.protocol == "*" || higher.protocol == lower.protocol) ||
+			!higher.sourcePrefixes.contains(lower.sourcePrefixes) ||
+			!higher.destinationPrefixes.contains(lower.destinationPrefixes) ||
+			!intRangesContain(higher.sourcePorts, lower.sourcePorts) ||
+			len(intersectIntRanges(remaining, higher.destinationPorts)) == 0 {
+			continue
+		}
+		remaining = subtractIntRanges(remaining, higher.destinationPorts)
+		related = append(related, higher)
+		if len(remaining) == 0 {
+			break
+		}
+	}
+	if len(remaining) > 0 || len(related) < 2 {
+		return SecurityRuleFinding{}, false
+	}
+
+	names := make([]string, 0, len(related))
+	for _, higher := range related {
+		names = append(names, strconv.Quote(higher.rule.Name))
+	}
+	for _, higher := range related {
+		if higher.rule.Action != lower.rule.Action {
+			return newSecurityRuleFinding(securityRuleShadowed, lower, higher,
+				"never matches, rules %s together match all of its traffic", strings.Join(names, ", ")), true
+		}
+	}
+	return newSecurityRuleFinding(securityRuleRedundant, lower, related[0],
+		"is fully covered by rules %s with the same action", strings.Join(names, ", ")), true
+}
+
+// covers reports whether the match space of the rule contains the whole match space of the other rule.
+func (m securityRuleMatch) covers(other securityRuleMatch) bool {
+	return (m.protocol == "*" || m.protocol == other.protocol) &&
+		m.sourcePrefixes.contains(other.sourcePrefixes) &&
+		m.destinationPrefixes.contains(other.destinationPrefixes) &&
+		intRangesContain(m.sourcePorts, other.sourcePorts) &&
+		intRangesContain(m.destinationPorts, other.destinationPorts)
+}
+
+// overlaps reports whether the match spaces of the rules share any traffic.
+func (m securityRuleMatch) overlaps(other securityRuleMatch) bool {
+	return (m.protocol == "*" || other.protocol == "*" || m.protocol == other.protocol) &&
+		m.sourcePrefixes.overlaps(other.sourcePrefixes) &&
+		m.destinationPrefixes.overlaps(other.destinationPrefixes) &&
+		len(intersectIntRanges(m.sourcePorts, other.sourcePorts)) > 0 &&
+		len(intersectIntRanges(m.destinationPorts, other.destinationPorts)) > 0
+}
+
+// newSecurityRuleFinding creates a finding of a rule in relation to a rule of higher priority.
+func newSecurityRuleFinding(findingType string, rule, related securityRuleMatch, format string, args ...any) SecurityRuleFinding {
+	return SecurityRuleFinding{
+		Type:            findingType,
+		Direction:       rule.rule.Direction,
+		Rule:            rule.rule.Name,
+		Priority:        rule.rule.Priority,
+		RelatedRule:     related.rule.Name,
+		RelatedPriority: related.rule.Priority,
+		Message:         fmt.Sprintf("%s rule %q (%d) ", rule.rule.Direction, rule.rule.Name, rule.rule.Priority) + fmt.Sprintf(format, args...),
+	}
+}
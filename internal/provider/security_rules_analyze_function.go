@@ -0,0 +1,195 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = SecurityRulesAnalyzeFunction{}
+)
+
+// NewSecurityRulesAnalyzeFunction is a helper function to create a new instance of SecurityRulesAnalyzeFunction.
+func NewSecurityRulesAnalyzeFunction() function.Function {
+	return SecurityRulesAnalyzeFunction{}
+}
+
+// SecurityRulesAnalyzeFunction is the struct for the security rules analyze function.
+type SecurityRulesAnalyzeFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f SecurityRulesAnalyzeFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "security_rules_analyze"
+}
+
+// Definition sets the definition for the function.
+func (f SecurityRulesAnalyzeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Find shadowed, redundant and conflicting security rules",
+		MarkdownDescription: "Evaluates NSG, network ACL or firewall rules in ascending priority order within each direction, and outputs a list of findings. " +
+			"A rule is `shadowed` when a single rule of higher priority with the opposite action matches all of its traffic, " +
+			"`redundant` when that rule has the same action, and in `conflict` when it partially overlaps a rule of higher priority with the opposite action. " +
+			"A rule is also `shadowed` or `redundant` when its destination ports are covered by the union of several rules of higher priority, " +
+			"like ports `80-90` under rules for `80-85` and `86-90`, as long as each of them covers its protocol, prefixes and source ports on its own. " +
+			"Rules sharing the same priority and direction are reported as `duplicate_priority`. " +
+			"Address prefixes are compared by containment, ports of TCP, UDP and SCTP rules by range set arithmetic, and service tags like `VirtualNetwork` only match themselves and the `*` wildcard. " +
+			"An empty list means the rule set has no findings.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name: "rules",
+				MarkdownDescription: "The list of rules, each an object with `name`, `priority`, `action` (`allow` or `deny`) and the optional attributes " +
+					"`direction` (`inbound` or `outbound`, defaults to `inbound`), `protocol` (defaults to `*`), " +
+					"which is a name like `tcp`, `udp`, `sctp`, `icmp`, `esp` or `ah`, or an IP protocol number like the AWS `6` for TCP and `-1` for all protocols, " +
+					"`source_prefixes`, `destination_prefixes`, `source_ports` and `destination_ports` (default to `*`). " +
+					"Prefixes and ports can be a single value or a list, ports can be numbers or ranges like `\"8000-8100\"`.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"type":             types.StringType,
+					"direction":        types.StringType,
+					"rule":             types.StringType,
+					"priority":         types.Int64Type,
+					"related_rule":     types.StringType,
+					"related_priority": types.Int64Type,
+					"message":          types.StringType,
+				},
+			},
+		},
+	}
+}
+
+// Run executes the security rules analyze function.
+func (f SecurityRulesAnalyzeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var rulesArgument types.Dynamic
+
+	// Parse the argument
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &rulesArgument))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input argument
+	rules, err := parseSecurityRules(rulesArgument)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error parsing security rules: %s", err.Error())))
+		return
+	}
+	if len(rules) == 0 {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The rules argument must be provided and valid"))
+		return
+	}
+
+	// Analyze the rules
+	findings, err := SecurityRulesAnalyze(rules)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error analyzing security rules: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, findings))
+}
+
+// parseSecurityRules converts the rules argument into security rules.
+func parseSecurityRules(value types.Dynamic) ([]SecurityRule, error) {
+	converted, err := dynamicToGo(value)
+	if err != nil || converted == nil {
+		return nil, err
+	}
+	list, err := goList(converted, "rules")
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]SecurityRule, 0, len(list))
+	for i, item := range list {
+		path := fmt.Sprintf("rules[%d]", i)
+		object, err := goObject(item, path)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkObjectKeys(object, path, "name", "priority", "direction", "action", "protocol",
+			"source_prefixes", "source_ports", "destination_prefixes", "destination_ports"); err != nil {
+			return nil, err
+		}
+
+		var rule SecurityRule
+		if rule.Name, err = goString(object["name"], path+".name"); err != nil {
+			return nil, err
+		}
+		if rule.Priority, err = goInt64(object["priority"], path+".priority"); err != nil {
+			return nil, err
+		}
+		if rule.Action, err = goString(object["action"], path+".action"); err != nil {
+			return nil, err
+		}
+		if object["direction"] != nil {
+			if rule.Direction, err = goString(object["direction"], path+".direction"); err != nil {
+				return nil, err
+			}
+		}
+		if object["protocol"] != nil {
+			if rule.Protocol, err = goString(object["protocol"], path+".protocol"); err != nil {
+				return nil, err
+			}
+		}
+		if object["source_prefixes"] != nil {
+			if rule.SourcePrefixes, err = goStringList(object["source_prefixes"], path+".source_prefixes"); err != nil {
+				return nil, err
+			}
+		}
+		if object["destination_prefixes"] != nil {
+			if rule.DestinationPrefixes, err = goStringList(object["destination_prefixes"], path+".destination_prefixes"); err != nil {
+				return nil, err
+			}
+		}
+		if object["source_ports"] != nil {
+			if rule.SourcePorts, err = goPortSpecs(object["source_ports"], path+".source_ports"); err != nil {
+				return nil, err
+			}
+		}
+		if object["destination_ports"] != nil {
+			if rule.DestinationPorts, err = goPortSpecs(object["destination_ports"], path+".destination_ports"); err != nil {
+				return nil, err
+			}
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// goPortSpecs converts a port number, a port specification string or a list of them into port specification strings.
+func goPortSpecs(value any, path string) ([]string, error) {
+	items, ok := value.([]any)
+	if !ok {
+		items = []any{value}
+	}
+	specs := make([]string, 0, len(items))
+	for i, item := range items {
+		switch v := item.(type) {
+		case string:
+			specs = append(specs, v)
+		case *big.Float:
+			port, err := goInt64(v, fmt.Sprintf("%s[%d]", path, i))
+			if err != nil {
+				return nil, err
+			}
+			specs = append(specs, fmt.Sprint(port))
+		default:
+			return nil, fmt.Errorf("%s must be a port, a port range or a list of them", path)
+		}
+	}
+	return specs, nil
+}
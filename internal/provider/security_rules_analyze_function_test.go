@@ -0,0 +1,237 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestSecurityRulesAnalyzeFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		rules    string
+		findings string
+	}{
+		"shadowed": {
+			rules: `[
+				{ name = "deny-all", priority = 100, action = "Deny" },
+				{ name = "allow-https", priority = 200, action = "Allow", protocol = "Tcp", destination_ports = 443 },
+			]`,
+			findings: `["shadowed:allow-https:deny-all"]`,
+		},
+		"redundant": {
+			rules: `[
+				{ name = "allow-web", priority = 100, action = "Allow", protocol = "Tcp", source_prefixes = "10.0.0.0/16", destination_ports = "80-443" },
+				{ name = "allow-https", priority = 200, action = "Allow", protocol = "Tcp", source_prefixes = ["10.0.1.0/24"], destination_ports = [443] },
+			]`,
+			findings: `["redundant:allow-https:allow-web"]`,
+		},
+		"shadowed-by-merged-prefixes": {
+			rules: `[
+				{ name = "allow-halves", priority = 100, action = "Allow", destination_prefixes = ["10.0.0.0/25", "10.0.0.128/25"] },
+				{ name = "deny-subnet", priority = 200, action = "Deny", destination_prefixes = "10.0.0.0/24" },
+			]`,
+			findings: `["shadowed:deny-subnet:allow-halves"]`,
+		},
+		"shadowed-by-port-union": {
+			rules: `[
+				{ name = "deny-low", priority = 100, action = "Deny", protocol = "Tcp", destination_ports = "80-85" },
+				{ name = "deny-high", priority = 110, action = "Deny", protocol = "Tcp", destination_ports = "86-90" },
+				{ name = "allow-web", priority = 200, action = "Allow", protocol = "Tcp", destination_ports = "80-90" },
+			]`,
+			findings: `["shadowed:allow-web:deny-low"]`,
+		},
+		"redundant-with-port-union": {
+			rules: `[
+				{ name = "allow-http", priority = 100, action = "Allow", protocol = "Tcp", destination_ports = ["80", "443"] },
+				{ name = "allow-alt", priority = 110, action = "Allow", protocol = "*", destination_ports = "8000-8100" },
+				{ name = "allow-web", priority = 200, action = "Allow", protocol = "Tcp", destination_ports = [80, 443, "8080"] },
+			]`,
+			findings: `["redundant:allow-web:allow-http"]`,
+		},
+		"port-union-with-gap": {
+			rules: `[
+				{ name = "deny-low", priority = 100, action = "Deny", protocol = "Tcp", destination_ports = "80-84" },
+				{ name = "deny-high", priority = 110, action = "Deny", protocol = "Tcp", destination_ports = "86-90" },
+				{ name = "allow-web", priority = 200, action = "Allow", protocol = "Tcp", destination_ports = "80-90" },
+			]`,
+			findings: `["conflict:allow-web:deny-low","conflict:allow-web:deny-high"]`,
+		},
+		"port-union-different-prefixes": {
+			rules: `[
+				{ name = "deny-low", priority = 100, action = "Deny", protocol = "Tcp", source_prefixes = "10.0.0.0/24", destination_ports = "80-85" },
+				{ name = "deny-high", priority = 110, action = "Deny", protocol = "Tcp", destination_ports = "86-90" },
+				{ name = "allow-web", priority = 200, action = "Allow", protocol = "Tcp", destination_ports = "80-90" },
+			]`,
+			findings: `["conflict:allow-web:deny-low","conflict:allow-web:deny-high"]`,
+		},
+		"conflict": {
+			rules: `[
+				{ name = "allow-web", priority = 100, action = "Allow", protocol = "Tcp", destination_ports = "80-8080" },
+				{ name = "deny-high", priority = 200, action = "Deny", protocol = "Tcp", destination_ports = "8000-9000" },
+			]`,
+			findings: `["conflict:deny-high:allow-web"]`,
+		},
+		"duplicate-priority": {
+			rules: `[
+				{ name = "allow-http", priority = 100, action = "Allow", protocol = "Tcp", destination_ports = 80 },
+				{ name = "allow-https", priority = 100, action = "Allow", protocol = "Tcp", destination_ports = 443 },
+			]`,
+			findings: `["duplicate_priority:allow-https:allow-http"]`,
+		},
+		"disjoint-ports": {
+			rules: `[
+				{ name = "allow-http", priority = 100, action = "Allow", protocol = "Tcp", destination_ports = 80 },
+				{ name = "deny-https", priority = 200, action = "Deny", protocol = "Tcp", destination_ports = 443 },
+			]`,
+			findings: `[]`,
+		},
+		"separate-directions": {
+			rules: `[
+				{ name = "deny-all-inbound", priority = 100, action = "Deny", direction = "Inbound" },
+				{ name = "allow-all-outbound", priority = 200, action = "Allow", direction = "Outbound" },
+			]`,
+			findings: `[]`,
+		},
+		"aws-protocol-numbers": {
+			rules: `[
+				{ name = "allow-https", priority = 100, action = "allow", protocol = "6", destination_ports = 443 },
+				{ name = "deny-ssh", priority = 110, action = "deny", protocol = "tcp", destination_ports = 22 },
+				{ name = "allow-https-again", priority = 120, action = "allow", protocol = "tcp", destination_ports = 443 },
+				{ name = "allow-dns", priority = 130, action = "allow", protocol = "17", destination_ports = 53 },
+			]`,
+			findings: `["redundant:allow-https-again:allow-https"]`,
+		},
+		"aws-all-protocols": {
+			rules: `[
+				{ name = "deny-all", priority = 100, action = "deny", protocol = "-1" },
+				{ name = "allow-sctp", priority = 200, action = "allow", protocol = "132", destination_ports = 3868 },
+			]`,
+			findings: `["shadowed:allow-sctp:deny-all"]`,
+		},
+		"sctp-ports": {
+			rules: `[
+				{ name = "allow-diameter", priority = 100, action = "Allow", protocol = "sctp", destination_ports = 3868 },
+				{ name = "deny-sigtran", priority = 200, action = "Deny", protocol = "132", destination_ports = 2905 },
+			]`,
+			findings: `[]`,
+		},
+		"service-tags": {
+			rules: `[
+				{ name = "deny-internet", priority = 100, action = "Deny", source_prefixes = "Internet" },
+				{ name = "allow-vnet", priority = 200, action = "Allow", source_prefixes = "VirtualNetwork" },
+				{ name = "allow-internet-https", priority = 300, action = "Allow", source_prefixes = "Internet", protocol = "Tcp", destination_ports = 443 },
+			]`,
+			findings: `["shadowed:allow-internet-https:deny-internet"]`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode([for finding in provider::iactools::security_rules_analyze(%s) : "${finding.type}:${finding.rule}:${finding.related_rule}"])
+							}
+						`, testCase.rules),
+						Check: resource.TestCheckOutput("result", testCase.findings),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestSecurityRulesAnalyzeFunction_Finding(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					output "result" {
+						value = jsonencode(provider::iactools::security_rules_analyze([
+							{ name = "deny-all", priority = 4000, action = "Deny", direction = "Outbound" },
+							{ name = "allow-dns", priority = 4010, action = "Allow", direction = "Outbound", protocol = "Udp", destination_ports = 53 },
+						]))
+					}
+				`,
+				Check: resource.TestCheckOutput("result", `[{"direction":"outbound","message":"outbound rule \"allow-dns\" (4010) never matches, rule \"deny-all\" denies all of its traffic",`+
+					`"priority":4010,"related_priority":4000,"related_rule":"deny-all","rule":"allow-dns","type":"shadowed"}]`),
+			},
+		},
+	})
+}
+
+func TestSecurityRulesAnalyzeFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		rules string
+		error string
+	}{
+		"empty-rules": {
+			rules: `[]`,
+			error: `(?s)Call to function "provider::iactools::security_rules_analyze" failed.*rules argument must.*be provided and valid`,
+		},
+		"invalid-action": {
+			rules: `[{ name = "a", priority = 100, action = "Permit" }]`,
+			error: `(?s)Call to function "provider::iactools::security_rules_analyze" failed.*invalid action.*"Permit"`,
+		},
+		"unknown-protocol": {
+			rules: `[{ name = "a", priority = 100, action = "Allow", protocol = "Tcpp" }]`,
+			error: `(?s)Call to function "provider::iactools::security_rules_analyze" failed.*invalid protocol.*"Tcpp"`,
+		},
+		"out-of-range-protocol-number": {
+			rules: `[{ name = "a", priority = 100, action = "Allow", protocol = "256" }]`,
+			error: `(?s)Call to function "provider::iactools::security_rules_analyze" failed.*invalid protocol.*"256"`,
+		},
+		"invalid-port": {
+			rules: `[{ name = "a", priority = 100, action = "Allow", destination_ports = "70000" }]`,
+			error: `(?s)Call to function "provider::iactools::security_rules_analyze" failed.*invalid port.*range.*"70000"`,
+		},
+		"invalid-prefix": {
+			rules: `[{ name = "a", priority = 100, action = "Allow", source_prefixes = "10.0.0.0/33" }]`,
+			error: `(?s)Call to function "provider::iactools::security_rules_analyze" failed.*invalid CIDR`,
+		},
+		"duplicate-name": {
+			rules: `[{ name = "a", priority = 100, action = "Allow" }, { name = "a", priority = 200, action = "Deny" }]`,
+			error: `(?s)Call to function "provider::iactools::security_rules_analyze" failed.*duplicate rule name "a"`,
+		},
+		"unsupported-attribute": {
+			rules: `[{ name = "a", priority = 100, action = "Allow", port = 80 }]`,
+			error: `(?s)Call to function "provider::iactools::security_rules_analyze" failed.*unsupported.*attributes: \[port\]`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::security_rules_analyze(%s)
+							}
+						`, testCase.rules),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+)
+
+// specialPurposeCIDRsJSON is the embedded catalog of the IANA special-purpose address registries
+// and the address ranges used internally by cloud platforms, Docker and Kubernetes.
+//
+//go:embed data/special_purpose_cidrs.json
+var specialPurposeCIDRsJSON []byte
+
+// Address families of the special-purpose catalog.
+const (
+	addressFamilyIPv4 = "ipv4"
+	addressFamilyIPv6 = "ipv6"
+)
+
+// specialPurposeRegistryIANA is the registry of the entries of the IANA special-purpose address registries.
+const specialPurposeRegistryIANA = "iana"
+
+// Relations of a classified prefix to a special-purpose entry.
+const (
+	specialPurposeRelationEqual    = "equal"
+	specialPurposeRelationWithin   = "within"
+	specialPurposeRelationContains = "contains"
+)
+
+// specialPurposeDataset is the structure of the embedded special-purpose catalog.
+type specialPurposeDataset struct {
+	Version string               `json:"version"`
+	Entries []SpecialPurposeCIDR `json:"entries"`
+}
+
+// SpecialPurposeCIDR holds an entry of the special-purpose catalog.
+// The IANA registry attributes are only set for the entries of the IANA registries.
+type SpecialPurposeCIDR struct {
+	CIDR               string  `json:"cidr" tfsdk:"cidr"`
+	Name               string  `json:"name" tfsdk:"name"`
+	Registry           string  `json:"registry" tfsdk:"registry"`
+	Reference          *string `json:"reference" tfsdk:"reference"`
+	Source             *bool   `json:"source" tfsdk:"source"`
+	Destination        *bool   `json:"destination" tfsdk:"destination"`
+	Forwardable        *bool   `json:"forwardable" tfsdk:"forwardable"`
+	GloballyReachable  *bool   `json:"globally_reachable" tfsdk:"globally_reachable"`
+	ReservedByProtocol *bool   `json:"reserved_by_protocol" tfsdk:"reserved_by_protocol"`
+}
+
+// SpecialPurposeMatch holds a special-purpose entry overlapping a classified prefix,
+// and whether the prefix is equal to, within or contains the entry.
+type SpecialPurposeMatch struct {
+	SpecialPurposeCIDR
+	Relation string `tfsdk:"relation"`
+}
+
+// specialPurposeNetwork is a catalog entry with its parsed network.
+type specialPurposeNetwork struct {
+	entry SpecialPurposeCIDR
+	ipnet *net.IPNet
+}
+
+// loadSpecialPurposeDataset parses the embedded catalog once.
+var loadSpecialPurposeDataset = sync.OnceValues(func() ([]specialPurposeNetwork, error) {
+	var dataset specialPurposeDataset
+	if err := json.Unmarshal(specialPurposeCIDRsJSON, &dataset); err != nil {
+		return nil, fmt.Errorf("cannot parse the special-purpose address catalog: %v", err)
+	}
+
+	networks := make([]specialPurposeNetwork, 0, len(dataset.Entries))
+	for _, entry := range dataset.Entries {
+		_, ipnet, err := net.ParseCIDR(entry.CIDR)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse the special-purpose address catalog: %v", err)
+		}
+		networks = append(networks, specialPurposeNetwork{entry: entry, ipnet: ipnet})
+	}
+	return networks, nil
+})
+
+// CIDRSpecialPurpose returns the entries of the special-purpose catalog of an address family, or of both families when it is empty.
+// Only the entries of the IANA registries are returned, unless the platform entries are included.
+func CIDRSpecialPurpose(family string, includePlatforms bool) ([]SpecialPurposeCIDR, error) {
+	networks, err := loadSpecialPurposeDataset()
+	if err != nil {
+		return nil, err
+	}
+
+	var length int
+	switch strings.ToLower(family) {
+	case "":
+	case addressFamilyIPv4:
+		length = net.IPv4len
+	case addressFamilyIPv6:
+		length = net.IPv6len
+	default:
+		return nil, fmt.Errorf("unknown address family %q, must be %s or %s", family, addressFamilyIPv4, addressFamilyIPv6)
+	}
+
+	entries := make([]SpecialPurposeCIDR, 0, len(networks))
+	for _, network := range networks {
+		if !includePlatforms && network.entry.Registry != specialPurposeRegistryIANA {
+			continue
+		}
+		if length == 0 || len(network.ipnet.IP) == length {
+			entries = append(entries, network.entry)
+		}
+	}
+	return entries, nil
+}
+
+// CIDRClassify returns the entries of the special-purpose catalog overlapping a CIDR or an IP address.
+func CIDRClassify(cidr string) ([]SpecialPurposeMatch, error) {
+	networks, err := loadSpecialPurposeDataset()
+	if err != nil {
+		return nil, err
+	}
+	prefix, err := parseIPOrCIDR(cidr)
+	if err != nil {
+		return nil, err
+	}
+	prefix = &net.IPNet{IP: prefix.IP.Mask(prefix.Mask), Mask: prefix.Mask}
+
+	matches := make([]SpecialPurposeMatch, 0)
+	for _, network := range networks {
+		if !ipNetsOverlap(prefix, network.ipnet) {
+			continue
+		}
+		relation := specialPurposeRelationContains
+		if compareIPNets(prefix, network.ipnet) == 0 {
+			relation = specialPurposeRelationEqual
+		} else if ipNetContains(network.ipnet, prefix) {
+			relation = specialPurposeRelationWithin
+		}
+		matches = append(matches, SpecialPurposeMatch{SpecialPurposeCIDR: network.entry, Relation: relation})
+	}
+	return matches, nil
+}
@@ -0,0 +1,107 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Alphabets of stable suffixes.
+const (
+	suffixAlphabetBase32 = "base32"
+	suffixAlphabetBase36 = "base36"
+	suffixAlphabetHex    = "hex"
+)
+
+// defaultSuffixAlphabet is the alphabet used when none is provided, lowercase letters and digits are allowed in most resource names.
+const defaultSuffixAlphabet = suffixAlphabetBase36
+
+// suffixInputSeparator separates the inputs of a stable suffix, so ["ab", "c"] and ["a", "bc"] have different suffixes.
+const suffixInputSeparator = "\x00"
+
+// Base36 suffixes are the 49 least significant base36 digits of the hash, the digits that are uniformly distributed.
+const (
+	suffixBase36Digits = "0123456789abcdefghijklmnopqrstuvwxyz"
+	suffixBase36Length = 49
+)
+
+// nameShortenHashLength is the length of the base36 hash appended to shortened names, 31 bits.
+const nameShortenHashLength = 6
+
+// nameShortenSeparators are the separators name_shorten puts before the hash, the first one found in the name is used.
+const nameShortenSeparators = "-_."
+
+// suffixEncodings encode a SHA-256 hash in the alphabets of stable suffixes, each output is as long as the longest possible encoding.
+var suffixEncodings = map[string]func(sum [sha256.Size]byte) string{
+	suffixAlphabetBase32: func(sum [sha256.Size]byte) string {
+		return strings.ToLower(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(sum[:]))
+	},
+	suffixAlphabetBase36: func(sum [sha256.Size]byte) string {
+		// The least significant digits come first, the most significant digit of a 256-bit number can only be 0 to 6
+		number, base, digit := new(big.Int).SetBytes(sum[:]), big.NewInt(36), new(big.Int)
+		encoded := make([]byte, suffixBase36Length)
+		for i := range encoded {
+			number.DivMod(number, base, digit)
+			encoded[i] = suffixBase36Digits[digit.Int64()]
+		}
+		return string(encoded)
+	},
+	suffixAlphabetHex: func(sum [sha256.Size]byte) string {
+		return hex.EncodeToString(sum[:])
+	},
+}
+
+// StableSuffix returns the first characters of the SHA-256 hash of the inputs joined with NUL characters,
+// encoded in lowercase base32 (RFC 4648 without padding), base36 from the least significant digit, or hex.
+func StableSuffix(inputs []string, length int, alphabet string) (string, error) {
+	if alphabet == "" {
+		alphabet = defaultSuffixAlphabet
+	}
+	encode, ok := suffixEncodings[strings.ToLower(alphabet)]
+	if !ok {
+		return "", fmt.Errorf("unknown alphabet %q, supported alphabets are %s, %s and %s", alphabet, suffixAlphabetBase32, suffixAlphabetBase36, suffixAlphabetHex)
+	}
+	if len(inputs) == 0 {
+		return "", fmt.Errorf("inputs must not be empty")
+	}
+
+	encoded := encode(sha256.Sum256([]byte(strings.Join(inputs, suffixInputSeparator))))
+	if length < 1 || length > len(encoded) {
+		return "", fmt.Errorf("the length must be between 1 and %d for the %s alphabet", len(encoded), strings.ToLower(alphabet))
+	}
+	return encoded[:length], nil
+}
+
+// NameShorten shortens a name longer than the maximum length to a prefix of the name followed by a base36 hash of the whole name,
+// separated by the first of -, _ or . found in the name, or by nothing when the name has none of them.
+func NameShorten(name string, maxLength int) (string, error) {
+	runes := []rune(name)
+	if len(runes) <= maxLength {
+		return name, nil
+	}
+
+	separator := ""
+	if index := strings.IndexAny(name, nameShortenSeparators); index >= 0 {
+		separator = name[index : index+1]
+	}
+	if maxLength < nameShortenHashLength+len(separator)+1 {
+		return "", fmt.Errorf("the maximum length must be at least %d to keep a prefix of the name and the hash", nameShortenHashLength+len(separator)+1)
+	}
+
+	hash, err := StableSuffix([]string{name}, nameShortenHashLength, suffixAlphabetBase36)
+	if err != nil {
+		return "", err
+	}
+	prefix := strings.TrimRight(string(runes[:maxLength-nameShortenHashLength-len(separator)]), nameShortenSeparators)
+	if prefix == "" {
+		return "", fmt.Errorf("the name %q has no characters other than separators to keep", name)
+	}
+	return prefix + separator + hash, nil
+}
@@ -0,0 +1,86 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = StableSuffixFunction{}
+)
+
+// NewStableSuffixFunction is a helper function to create a new instance of StableSuffixFunction.
+func NewStableSuffixFunction() function.Function {
+	return StableSuffixFunction{}
+}
+
+// StableSuffixFunction is the struct for the stable suffix function.
+type StableSuffixFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f StableSuffixFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "stable_suffix"
+}
+
+// Definition sets the definition for the function.
+func (f StableSuffixFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Derive a deterministic suffix from a list of strings",
+		MarkdownDescription: "Hashes the inputs joined with NUL characters with SHA-256 and outputs the first `length` characters of the hash " +
+			"in lowercase `base32` (RFC 4648 without padding, up to 52 characters), `base36` (up to 49 characters) or `hex` (up to 64 characters). " +
+			"The `base36` suffix is the hash as a number written from its least significant digit, so every character is uniformly distributed. " +
+			"The same inputs always produce the same suffix on every platform, so it can replace `random_string` in names that must not change between applies. " +
+			"Each character holds 4 bits in `hex`, 5 bits in `base32` and about 5.17 bits in `base36`. " +
+			"Two suffixes of `b` bits collide with a probability of about 50% after about 1.18 × 2^(b/2) different inputs, " +
+			"e.g. 6 `base36` characters (31 bits) after about 55,000 inputs and 8 characters (41.4 bits) after about 2 million, " +
+			"and with a probability of about n²/2^(b+1) for `n` inputs, e.g. 0.02% for 1,000 names with 6 `base36` characters.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "inputs",
+				ElementType:         types.StringType,
+				MarkdownDescription: "The strings the suffix is derived from, e.g. the subscription ID, the environment and the workload",
+			},
+			function.Int64Parameter{
+				Name:                "length",
+				MarkdownDescription: "The number of characters of the suffix",
+			},
+			function.StringParameter{
+				Name:                "alphabet",
+				MarkdownDescription: "The alphabet of the suffix: `base32`, `base36` (the default) or `hex`. Can be null.",
+				AllowNullValue:      true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run executes the stable suffix function.
+func (f StableSuffixFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var inputs []string
+	var length int64
+	var alphabet *string
+
+	// Parse the arguments
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &inputs, &length, &alphabet))
+	if resp.Error != nil {
+		return
+	}
+
+	// Derive the suffix
+	suffix, err := StableSuffix(inputs, int(length), stringValueOrEmpty(alphabet))
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error deriving stable suffix: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.StringValue(suffix)))
+}
@@ -0,0 +1,118 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestStableSuffixFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		result    string
+	}{
+		"base36-default": {
+			arguments: `["sub-123", "prod", "payments"], 6, null`,
+			result:    `"l3j10b"`,
+		},
+		"base36": {
+			arguments: `["a"], 8, "base36"`,
+			result:    `"758eg605"`,
+		},
+		"base36-full-length": {
+			arguments: `["a"], 49, "base36"`,
+			result:    `"758eg6057boyx998uts8t098shhur79pbmhg30a8us5lszzr1"`,
+		},
+		"base32": {
+			arguments: `["a"], 8, "base32"`,
+			result:    `"zklycewk"`,
+		},
+		"hex": {
+			arguments: `["a"], 8, "hex"`,
+			result:    `"ca978112"`,
+		},
+		"input-boundaries": {
+			arguments: `["ab", "c"], 6, null`,
+			result:    `"rpe5eg"`,
+		},
+		"input-boundaries-moved": {
+			arguments: `["a", "bc"], 6, null`,
+			result:    `"ci2wfu"`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::stable_suffix(%s))
+							}
+						`, testCase.arguments),
+						Check: resource.TestCheckOutput("result", testCase.result),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestStableSuffixFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		error     string
+	}{
+		"empty-inputs": {
+			arguments: `[], 6, null`,
+			error:     `(?s)Call to function "provider::iactools::stable_suffix" failed.*inputs\s+must\s+not\s+be\s+empty`,
+		},
+		"unknown-alphabet": {
+			arguments: `["a"], 6, "base64"`,
+			error:     `(?s)Call to function "provider::iactools::stable_suffix" failed.*unknown\s+alphabet\s+"base64",\s+supported\s+alphabets\s+are\s+base32,\s+base36\s+and\s+hex`,
+		},
+		"base36-too-long": {
+			arguments: `["a"], 50, "base36"`,
+			error:     `(?s)Call to function "provider::iactools::stable_suffix" failed.*the\s+length\s+must\s+be\s+between\s+1\s+and\s+49\s+for\s+the\s+base36\s+alphabet`,
+		},
+		"zero-length": {
+			arguments: `["a"], 0, null`,
+			error:     `(?s)Call to function "provider::iactools::stable_suffix" failed.*the\s+length\s+must\s+be\s+between\s+1\s+and\s+49\s+for\s+the\s+base36\s+alphabet`,
+		},
+		"long-base32": {
+			arguments: `["a"], 53, "base32"`,
+			error:     `(?s)Call to function "provider::iactools::stable_suffix" failed.*the\s+length\s+must\s+be\s+between\s+1\s+and\s+52\s+for\s+the\s+base32\s+alphabet`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::stable_suffix(%s)
+							}
+						`, testCase.arguments),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
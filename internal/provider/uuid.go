@@ -0,0 +1,197 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Variants of UUIDs, the variant of the UUIDs of RFC 9562 was defined in RFC 4122.
+const (
+	uuidVariantNCS       = "ncs"
+	uuidVariantRFC9562   = "rfc9562"
+	uuidVariantMicrosoft = "microsoft"
+	uuidVariantFuture    = "future"
+)
+
+// uuidURNPrefix is the prefix of UUIDs written as URNs.
+const uuidURNPrefix = "urn:uuid:"
+
+// uuidGregorianOffset is the number of 100-nanosecond intervals between the start of the Gregorian calendar,
+// 1582-10-15, and the Unix epoch, the timestamps of version 1 and 6 UUIDs count from the former.
+const uuidGregorianOffset = 122192928000000000
+
+// uuidMaxV7Milliseconds is the largest Unix timestamp in milliseconds of a version 7 UUID, it has 48 bits.
+const uuidMaxV7Milliseconds = 1<<48 - 1
+
+// uuidNamespaces are the namespaces of RFC 9562 by the names the Terraform uuidv5 function uses for them.
+var uuidNamespaces = map[string]string{
+	"dns":  "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+	"url":  "6ba7b811-9dad-11d1-80b4-00c04fd430c8",
+	"oid":  "6ba7b812-9dad-11d1-80b4-00c04fd430c8",
+	"x500": "6ba7b814-9dad-11d1-80b4-00c04fd430c8",
+}
+
+// UUIDInfo holds the fields of a UUID.
+type UUIDInfo struct {
+	UUID      string  `tfsdk:"uuid"`
+	Version   int64   `tfsdk:"version"`
+	Variant   string  `tfsdk:"variant"`
+	Timestamp *string `tfsdk:"timestamp"`
+}
+
+// parseUUID reads a UUID in the 8-4-4-4-12 hex form in any case, optionally in braces or as a URN.
+func parseUUID(value string) ([16]byte, error) {
+	var result [16]byte
+	text := strings.TrimPrefix(strings.ToLower(value), uuidURNPrefix)
+	if strings.HasPrefix(text, "{") && strings.HasSuffix(text, "}") {
+		text = text[1 : len(text)-1]
+	}
+	if len(text) != 36 || text[8] != '-' || text[13] != '-' || text[18] != '-' || text[23] != '-' {
+		return result, fmt.Errorf("invalid UUID %q, it must have the format xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx", value)
+	}
+	// Every group is decoded on its own so a hyphen inside a group is rejected
+	offset := 0
+	for _, group := range [][2]int{{0, 8}, {9, 13}, {14, 18}, {19, 23}, {24, 36}} {
+		if _, err := hex.Decode(result[offset:], []byte(text[group[0]:group[1]])); err != nil {
+			return result, fmt.Errorf("invalid UUID %q, it must only contain hex digits", value)
+		}
+		offset += (group[1] - group[0]) / 2
+	}
+	return result, nil
+}
+
+// formatUUID writes a UUID in the lowercase 8-4-4-4-12 hex form.
+func formatUUID(uuid [16]byte) string {
+	text := hex.EncodeToString(uuid[:])
+	return text[0:8] + "-" + text[8:12] + "-" + text[12:16] + "-" + text[16:20] + "-" + text[20:32]
+}
+
+// setUUIDVersion sets the version and the RFC 9562 variant bits of a UUID.
+func setUUIDVersion(uuid *[16]byte, version byte) {
+	uuid[6] = uuid[6]&0x0f | version<<4
+	uuid[8] = uuid[8]&0x3f | 0x80
+}
+
+// parseUUIDNamespace reads a namespace UUID or one of the names dns, url, oid and x500.
+func parseUUIDNamespace(namespace string) ([16]byte, error) {
+	if known, ok := uuidNamespaces[strings.ToLower(namespace)]; ok {
+		namespace = known
+	}
+	return parseUUID(namespace)
+}
+
+// nameBasedUUID hashes the namespace followed by the name and sets the version of the UUID, following RFC 9562 section 5.3 and 5.5.
+func nameBasedUUID(namespace, name string, version byte) (string, error) {
+	namespaceUUID, err := parseUUIDNamespace(namespace)
+	if err != nil {
+		return "", fmt.Errorf("invalid namespace: %v", err)
+	}
+	data := append(namespaceUUID[:], name...)
+	var uuid [16]byte
+	if version == 3 {
+		sum := md5.Sum(data)
+		copy(uuid[:], sum[:])
+	} else {
+		sum := sha1.Sum(data)
+		copy(uuid[:], sum[:])
+	}
+	setUUIDVersion(&uuid, version)
+	return formatUUID(uuid), nil
+}
+
+// UUIDv3 returns the version 3 UUID of a name in a namespace, the MD5 hash of the namespace and the name.
+func UUIDv3(namespace, name string) (string, error) {
+	return nameBasedUUID(namespace, name, 3)
+}
+
+// UUIDv5 returns the version 5 UUID of a name in a namespace, the SHA-1 hash of the namespace and the name.
+func UUIDv5(namespace, name string) (string, error) {
+	return nameBasedUUID(namespace, name, 5)
+}
+
+// UUIDv7 returns the version 7 UUID of an RFC 3339 timestamp, the Unix time in milliseconds followed by 74 bits
+// taken from the SHA-256 hash of the seed instead of random bits, so the same timestamp and seed always give the same UUID.
+func UUIDv7(timestamp, seed string) (string, error) {
+	parsed, err := time.Parse(time.RFC3339Nano, timestamp)
+	if err != nil {
+		return "", fmt.Errorf("invalid RFC 3339 timestamp %q", timestamp)
+	}
+	milliseconds := parsed.UnixMilli()
+	if milliseconds < 0 || milliseconds > uuidMaxV7Milliseconds {
+		return "", fmt.Errorf("the timestamp %s is outside of the range of version 7 UUIDs, from 1970 to 10889", timestamp)
+	}
+
+	var uuid [16]byte
+	sum := sha256.Sum256([]byte(seed))
+	copy(uuid[6:], sum[:10])
+	for i := 0; i < 6; i++ {
+		uuid[i] = byte(milliseconds >> (40 - 8*i))
+	}
+	setUUIDVersion(&uuid, 7)
+	return formatUUID(uuid), nil
+}
+
+// ParseUUID returns the canonical form, version and variant of a UUID,
+// and the timestamp of the time-based versions 1, 6 and 7 of the RFC 9562 variant.
+func ParseUUID(value string) (UUIDInfo, error) {
+	uuid, err := parseUUID(value)
+	if err != nil {
+		return UUIDInfo{}, err
+	}
+	result := UUIDInfo{
+		UUID:    formatUUID(uuid),
+		Version: int64(uuid[6] >> 4),
+	}
+	switch {
+	case uuid[8]&0x80 == 0:
+		result.Variant = uuidVariantNCS
+	case uuid[8]&0xc0 == 0x80:
+		result.Variant = uuidVariantRFC9562
+	case uuid[8]&0xe0 == 0xc0:
+		result.Variant = uuidVariantMicrosoft
+	default:
+		result.Variant = uuidVariantFuture
+	}
+	if result.Variant != uuidVariantRFC9562 {
+		return result, nil
+	}
+
+	// Version 1 and 6 count 100-nanosecond intervals since 1582-10-15, version 7 counts milliseconds since 1970-01-01
+	var timestamp time.Time
+	switch result.Version {
+	case 1:
+		intervals := int64(uuid[6]&0x0f)<<56 | int64(uuid[7])<<48 | int64(uuid[4])<<40 | int64(uuid[5])<<32 |
+			int64(uuid[0])<<24 | int64(uuid[1])<<16 | int64(uuid[2])<<8 | int64(uuid[3])
+		timestamp = gregorianIntervalsTime(intervals)
+	case 6:
+		intervals := int64(uuid[0])<<52 | int64(uuid[1])<<44 | int64(uuid[2])<<36 | int64(uuid[3])<<28 |
+			int64(uuid[4])<<20 | int64(uuid[5])<<12 | int64(uuid[6]&0x0f)<<8 | int64(uuid[7])
+		timestamp = gregorianIntervalsTime(intervals)
+	case 7:
+		var milliseconds int64
+		for i := 0; i < 6; i++ {
+			milliseconds = milliseconds<<8 | int64(uuid[i])
+		}
+		timestamp = time.UnixMilli(milliseconds)
+	default:
+		return result, nil
+	}
+	formatted := timestamp.UTC().Format(time.RFC3339Nano)
+	result.Timestamp = &formatted
+	return result, nil
+}
+
+// gregorianIntervalsTime converts a count of 100-nanosecond intervals since 1582-10-15 into a time.
+func gregorianIntervalsTime(intervals int64) time.Time {
+	intervals -= uuidGregorianOffset
+	return time.Unix(intervals/10000000, intervals%10000000*100)
+}
@@ -0,0 +1,83 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = UUIDParseFunction{}
+)
+
+// NewUUIDParseFunction is a helper function to create a new instance of UUIDParseFunction.
+func NewUUIDParseFunction() function.Function {
+	return UUIDParseFunction{}
+}
+
+// UUIDParseFunction is the struct for the UUID parse function.
+type UUIDParseFunction struct{}
+
+// Metadata sets the metadata for the function.
+func (f UUIDParseFunction) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "uuid_parse"
+}
+
+// Definition sets the definition for the function.
+func (f UUIDParseFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse a UUID into its version, variant and timestamp",
+		MarkdownDescription: "Accepts a UUID in the `xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx` form in any case, optionally in braces or with the `urn:uuid:` prefix. " +
+			"Outputs the lowercase `uuid`, the `version` and the `variant`: `rfc9562` for the UUIDs of RFC 9562 and RFC 4122, `ncs`, `microsoft` or `future`. " +
+			"The `timestamp` is the RFC 3339 time of the time-based versions 1, 6 and 7 of the `rfc9562` variant, and null for the other UUIDs.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "uuid",
+				MarkdownDescription: "The UUID to parse",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"uuid":      types.StringType,
+				"version":   types.Int64Type,
+				"variant":   types.StringType,
+				"timestamp": types.StringType,
+			},
+		},
+	}
+}
+
+// Run executes the UUID parse function.
+func (f UUIDParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var uuid string
+
+	// Parse the argument
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &uuid))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input argument
+	if uuid == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The uuid argument must be provided and valid"))
+		return
+	}
+
+	// Parse the UUID
+	info, err := ParseUUID(uuid)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error parsing UUID: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, info))
+}
@@ -0,0 +1,110 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestUUIDParseFunction_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		result    string
+	}{
+		"v1": {
+			arguments: `"C232AB00-9414-11EC-B3C8-9F6BDECED846"`,
+			result:    `{"timestamp":"2022-02-22T19:22:22Z","uuid":"c232ab00-9414-11ec-b3c8-9f6bdeced846","variant":"rfc9562","version":1}`,
+		},
+		"v6-braces": {
+			arguments: `"{1EC9414C-232A-6B00-B3C8-9F6BDECED846}"`,
+			result:    `{"timestamp":"2022-02-22T19:22:22Z","uuid":"1ec9414c-232a-6b00-b3c8-9f6bdeced846","variant":"rfc9562","version":6}`,
+		},
+		"v7-urn": {
+			arguments: `"urn:uuid:017F22E2-79B0-7CC3-98C4-DC0C0C07398F"`,
+			result:    `{"timestamp":"2022-02-22T19:22:22Z","uuid":"017f22e2-79b0-7cc3-98c4-dc0c0c07398f","variant":"rfc9562","version":7}`,
+		},
+		"v5": {
+			arguments: `"cfbff0d1-9375-5685-968c-48ce8b15ae17"`,
+			result:    `{"timestamp":null,"uuid":"cfbff0d1-9375-5685-968c-48ce8b15ae17","variant":"rfc9562","version":5}`,
+		},
+		"nil": {
+			arguments: `"00000000-0000-0000-0000-000000000000"`,
+			result:    `{"timestamp":null,"uuid":"00000000-0000-0000-0000-000000000000","variant":"ncs","version":0}`,
+		},
+		"max": {
+			arguments: `"ffffffff-ffff-ffff-ffff-ffffffffffff"`,
+			result:    `{"timestamp":null,"uuid":"ffffffff-ffff-ffff-ffff-ffffffffffff","variant":"future","version":15}`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::uuid_parse(%s))
+							}
+						`, testCase.arguments),
+						Check: resource.TestCheckOutput("result", testCase.result),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestUUIDParseFunction_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		error     string
+	}{
+		"empty-uuid": {
+			arguments: `""`,
+			error:     `(?s)Call to function "provider::iactools::uuid_parse" failed.*The\s+uuid\s+argument\s+must\s+be\s+provided\s+and\s+valid`,
+		},
+		"misplaced-hyphens": {
+			arguments: `"c232ab00-9414-11ec-b3c8-9f6bdece--46"`,
+			error:     `(?s)Call to function "provider::iactools::uuid_parse" failed.*invalid\s+UUID\s+"c232ab00-9414-11ec-b3c8-9f6bdece--46",\s+it\s+must\s+only\s+contain\s+hex\s+digits`,
+		},
+		"hyphen-instead-of-digit": {
+			arguments: `"c232ab00-9414-11ec-b3c8-9f6b-eced846"`,
+			error:     `(?s)Call to function "provider::iactools::uuid_parse" failed.*invalid\s+UUID\s+"c232ab00-9414-11ec-b3c8-9f6b-eced846",\s+it\s+must\s+only\s+contain\s+hex\s+digits`,
+		},
+		"no-hyphens": {
+			arguments: `"c232ab00941411ecb3c89f6bdeced846"`,
+			error:     `(?s)Call to function "provider::iactools::uuid_parse" failed.*invalid\s+UUID\s+"c232ab00941411ecb3c89f6bdeced846",\s+it\s+must\s+have\s+the\s+format\s+xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::uuid_parse(%s)
+							}
+						`, testCase.arguments),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = UUIDV3Function{}
+)
+
+// NewUUIDV3Function is a helper function to create a new instance of UUIDV3Function.
+func NewUUIDV3Function() function.Function {
+	return UUIDV3Function{}
+}
+
+// UUIDV3Function is the struct for the UUID version 3 function.
+type UUIDV3Function struct{}
+
+// Metadata sets the metadata for the function.
+func (f UUIDV3Function) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "uuid_v3"
+}
+
+// Definition sets the definition for the function.
+func (f UUIDV3Function) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Generate a name-based version 3 UUID in any namespace",
+		MarkdownDescription: "Outputs the version 3 UUID of RFC 9562, the MD5 hash of the namespace UUID followed by the name, " +
+			"so the same namespace and name always give the same UUID. " +
+			"Unlike the Terraform `uuidv3` function the namespace can be any UUID, e.g. a subscription ID for the names of Azure role assignments, " +
+			"or one of the namespaces `dns`, `url`, `oid` and `x500` of RFC 9562.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "namespace",
+				MarkdownDescription: "The namespace UUID, or `dns`, `url`, `oid` or `x500`",
+			},
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "The name in the namespace",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run executes the UUID version 3 function.
+func (f UUIDV3Function) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var namespace, name string
+
+	// Parse the arguments
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &namespace, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input argument
+	if namespace == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The namespace argument must be provided and valid"))
+		return
+	}
+
+	// Generate the UUID
+	uuid, err := UUIDv3(namespace, name)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error generating UUID: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.StringValue(uuid)))
+}
@@ -0,0 +1,86 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestUUIDV3Function_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		result    string
+	}{
+		"dns-namespace": {
+			arguments: `"dns", "www.example.com"`,
+			result:    `"5df41881-3aed-3515-88a7-2f4a814cf09e"`,
+		},
+		"namespace-uuid": {
+			arguments: `"6ba7b810-9dad-11d1-80b4-00c04fd430c8", "www.example.com"`,
+			result:    `"5df41881-3aed-3515-88a7-2f4a814cf09e"`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::uuid_v3(%s))
+							}
+						`, testCase.arguments),
+						Check: resource.TestCheckOutput("result", testCase.result),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestUUIDV3Function_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		error     string
+	}{
+		"empty-namespace": {
+			arguments: `"", "www.example.com"`,
+			error:     `(?s)Call to function "provider::iactools::uuid_v3" failed.*The\s+namespace\s+argument\s+must\s+be\s+provided\s+and\s+valid`,
+		},
+		"invalid-namespace": {
+			arguments: `"example", "www.example.com"`,
+			error:     `(?s)Call to function "provider::iactools::uuid_v3" failed.*invalid\s+namespace:\s+invalid\s+UUID\s+"example",\s+it\s+must\s+have\s+the\s+format\s+xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::uuid_v3(%s)
+							}
+						`, testCase.arguments),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = UUIDV5Function{}
+)
+
+// NewUUIDV5Function is a helper function to create a new instance of UUIDV5Function.
+func NewUUIDV5Function() function.Function {
+	return UUIDV5Function{}
+}
+
+// UUIDV5Function is the struct for the UUID version 5 function.
+type UUIDV5Function struct{}
+
+// Metadata sets the metadata for the function.
+func (f UUIDV5Function) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "uuid_v5"
+}
+
+// Definition sets the definition for the function.
+func (f UUIDV5Function) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Generate a name-based version 5 UUID in any namespace",
+		MarkdownDescription: "Outputs the version 5 UUID of RFC 9562, the SHA-1 hash of the namespace UUID followed by the name, " +
+			"so the same namespace and name always give the same UUID. " +
+			"Unlike the Terraform `uuidv5` function the namespace can be any UUID, e.g. a subscription ID for the names of Azure role assignments, " +
+			"or one of the namespaces `dns`, `url`, `oid` and `x500` of RFC 9562.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "namespace",
+				MarkdownDescription: "The namespace UUID, or `dns`, `url`, `oid` or `x500`",
+			},
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "The name in the namespace",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run executes the UUID version 5 function.
+func (f UUIDV5Function) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var namespace, name string
+
+	// Parse the arguments
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &namespace, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input argument
+	if namespace == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The namespace argument must be provided and valid"))
+		return
+	}
+
+	// Generate the UUID
+	uuid, err := UUIDv5(namespace, name)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error generating UUID: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.StringValue(uuid)))
+}
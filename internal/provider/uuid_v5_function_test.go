@@ -0,0 +1,94 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestUUIDV5Function_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		result    string
+	}{
+		"dns-namespace": {
+			arguments: `"dns", "www.example.com"`,
+			result:    `"2ed6657d-e927-568b-95e1-2665a8aea6a2"`,
+		},
+		"upper-case-namespace-uuid": {
+			arguments: `"6BA7B810-9DAD-11D1-80B4-00C04FD430C8", "www.example.com"`,
+			result:    `"2ed6657d-e927-568b-95e1-2665a8aea6a2"`,
+		},
+		"subscription-namespace": {
+			arguments: `"00000000-0000-0000-0000-000000000001", "role-assignment"`,
+			result:    `"552d3c83-b110-5a8c-9996-ed04e3e2c03d"`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::uuid_v5(%s))
+							}
+						`, testCase.arguments),
+						Check: resource.TestCheckOutput("result", testCase.result),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestUUIDV5Function_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		error     string
+	}{
+		"empty-namespace": {
+			arguments: `"", "www.example.com"`,
+			error:     `(?s)Call to function "provider::iactools::uuid_v5" failed.*The\s+namespace\s+argument\s+must\s+be\s+provided\s+and\s+valid`,
+		},
+		"misplaced-hyphens": {
+			arguments: `"6ba7b810-9dad-11d1-80b4-00c04fd4--c8", "www.example.com"`,
+			error:     `(?s)Call to function "provider::iactools::uuid_v5" failed.*invalid\s+namespace:\s+invalid\s+UUID\s+"6ba7b810-9dad-11d1-80b4-00c04fd4--c8",\s+it\s+must\s+only\s+contain\s+hex\s+digits`,
+		},
+		"invalid-hex": {
+			arguments: `"6ba7b810-9dad-11d1-80b4-00c04fd430zz", "www.example.com"`,
+			error:     `(?s)Call to function "provider::iactools::uuid_v5" failed.*invalid\s+namespace:\s+invalid\s+UUID\s+"6ba7b810-9dad-11d1-80b4-00c04fd430zz",\s+it\s+must\s+only\s+contain\s+hex\s+digits`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::uuid_v5(%s)
+							}
+						`, testCase.arguments),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
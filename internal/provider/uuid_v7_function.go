@@ -0,0 +1,81 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ function.Function = UUIDV7Function{}
+)
+
+// NewUUIDV7Function is a helper function to create a new instance of UUIDV7Function.
+func NewUUIDV7Function() function.Function {
+	return UUIDV7Function{}
+}
+
+// UUIDV7Function is the struct for the UUID version 7 function.
+type UUIDV7Function struct{}
+
+// Metadata sets the metadata for the function.
+func (f UUIDV7Function) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "uuid_v7"
+}
+
+// Definition sets the definition for the function.
+func (f UUIDV7Function) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Generate a deterministic time-ordered version 7 UUID",
+		MarkdownDescription: "Outputs the version 7 UUID of RFC 9562 for a timestamp: the Unix time in milliseconds followed by 74 bits " +
+			"taken from the SHA-256 hash of the seed where RFC 9562 uses random bits. " +
+			"The same timestamp and seed always give the same UUID, and UUIDs sort by their timestamps. " +
+			"Use a fixed timestamp, e.g. from `plantimestamp()` stored in a `terraform_data` resource, to keep the UUID stable between applies. " +
+			"Sub-millisecond precision of the timestamp is ignored.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "timestamp",
+				MarkdownDescription: "The RFC 3339 timestamp, e.g. `2026-10-19T12:00:00Z`",
+			},
+			function.StringParameter{
+				Name:                "seed",
+				MarkdownDescription: "The string the bits after the timestamp are derived from",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run executes the UUID version 7 function.
+func (f UUIDV7Function) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var timestamp, seed string
+
+	// Parse the arguments
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &timestamp, &seed))
+	if resp.Error != nil {
+		return
+	}
+
+	// Validate input argument
+	if timestamp == "" {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("The timestamp argument must be provided and valid"))
+		return
+	}
+
+	// Generate the UUID
+	uuid, err := UUIDv7(timestamp, seed)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(fmt.Sprintf("Error generating UUID: %s", err.Error())))
+		return
+	}
+
+	// Set the result
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, types.StringValue(uuid)))
+}
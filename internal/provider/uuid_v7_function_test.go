@@ -0,0 +1,90 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestUUIDV7Function_Valid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		result    string
+	}{
+		"timestamp": {
+			arguments: `"2022-02-22T19:22:22Z", "seed"`,
+			result:    `"017f22e2-79b0-79b2-9856-e1c150ca834c"`,
+		},
+		"sub-millisecond": {
+			arguments: `"2026-10-19T12:00:00.123456Z", "orders"`,
+			result:    `"01a15408-6a7b-7c16-8adb-00d208e42f93"`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = jsonencode(provider::iactools::uuid_v7(%s))
+							}
+						`, testCase.arguments),
+						Check: resource.TestCheckOutput("result", testCase.result),
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestUUIDV7Function_Invalid(t *testing.T) {
+	testCases := map[string]struct {
+		arguments string
+		error     string
+	}{
+		"empty-timestamp": {
+			arguments: `"", "seed"`,
+			error:     `(?s)Call to function "provider::iactools::uuid_v7" failed.*The\s+timestamp\s+argument\s+must\s+be\s+provided\s+and\s+valid`,
+		},
+		"invalid-timestamp": {
+			arguments: `"2022-02-22", "seed"`,
+			error:     `(?s)Call to function "provider::iactools::uuid_v7" failed.*invalid\s+RFC\s+3339\s+timestamp\s+"2022-02-22"`,
+		},
+		"before-1970": {
+			arguments: `"1969-12-31T23:59:59Z", "seed"`,
+			error:     `(?s)Call to function "provider::iactools::uuid_v7" failed.*the\s+timestamp\s+1969-12-31T23:59:59Z\s+is\s+outside\s+of\s+the\s+range\s+of\s+version\s+7\s+UUIDs,\s+from\s+1970\s+to\s+10889`,
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+					tfversion.SkipBelow(tfversion.Version1_8_0),
+				},
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							output "result" {
+								value = provider::iactools::uuid_v7(%s)
+							}
+						`, testCase.arguments),
+						ExpectError: regexp.MustCompile(testCase.error),
+					},
+				},
+			})
+		})
+	}
+}
@@ -0,0 +1,30 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+
+locals {
+  parsed = provider::iactools::arn_parse(var.arn)
+}
+
+output "service" {
+  value = local.parsed.service
+}
+
+output "resource_type" {
+  value = local.parsed.resource_type
+}
+
+output "resource_id" {
+  value = local.parsed.resource_id
+}
+
+output "rebuilt_arn" {
+  value = provider::iactools::arn_build({
+    partition  = local.parsed.partition
+    service    = local.parsed.service
+    region     = local.parsed.region
+    account_id = local.parsed.account_id
+    resource   = local.parsed.resource
+  })
+}
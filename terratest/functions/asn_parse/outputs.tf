@@ -0,0 +1,20 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+
+locals {
+  asn = provider::iactools::asn_parse(var.value)
+}
+
+output "asn" {
+  value = local.asn.asn
+}
+
+output "asdot" {
+  value = local.asn.asdot
+}
+
+output "is_private" {
+  value = local.asn.is_private
+}
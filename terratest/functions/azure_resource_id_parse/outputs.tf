@@ -0,0 +1,32 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+
+locals {
+  parsed = provider::iactools::azure_resource_id_parse(var.id)
+}
+
+output "resource_type" {
+  value = local.parsed.resource_type
+}
+
+output "names" {
+  value = local.parsed.names
+}
+
+output "parent_id" {
+  value = local.parsed.parent_id
+}
+
+output "scope_level" {
+  value = local.parsed.scope_level
+}
+
+output "rebuilt_id" {
+  value = provider::iactools::azure_resource_id_build({
+    scope         = local.parsed.scope
+    resource_type = local.parsed.resource_type
+    names         = local.parsed.names
+  })
+}
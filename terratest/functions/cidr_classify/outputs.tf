@@ -0,0 +1,8 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+
+output "matches" {
+  value = [for entry in provider::iactools::cidr_classify(var.cidr) : "${entry.registry} ${entry.cidr} ${entry.relation}"]
+}
@@ -0,0 +1,20 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+
+locals {
+  utilization = provider::iactools::cidr_utilization(var.parent, var.allocated)
+}
+
+output "utilization" {
+  value = local.utilization.utilization
+}
+
+output "free_blocks" {
+  value = local.utilization.free_blocks
+}
+
+output "fragmentation" {
+  value = local.utilization.fragmentation
+}
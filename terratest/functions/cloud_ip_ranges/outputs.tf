@@ -0,0 +1,11 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+
+output "prefixes" {
+  value = provider::iactools::cloud_ip_ranges(file("${path.module}/ip-ranges.json"), "aws", {
+    region    = var.region
+    summarize = var.summarize
+  })
+}
@@ -0,0 +1,7 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+output "dnssec_ds" {
+  value = provider::iactools::dnssec_ds(var.owner, var.dnskey_rdata, var.digest_type).record
+}
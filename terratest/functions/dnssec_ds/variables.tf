@@ -0,0 +1,15 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+variable "owner" {
+  type = string
+}
+
+variable "dnskey_rdata" {
+  type = string
+}
+
+variable "digest_type" {
+  type = number
+}
@@ -0,0 +1,8 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+
+output "ipv6_subnets" {
+  value = provider::iactools::dualstack_map(var.ipv4_subnets, var.ipv6_parent, var.strategy)
+}
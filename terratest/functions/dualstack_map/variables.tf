@@ -0,0 +1,16 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+
+variable "ipv4_subnets" {
+  type = list(string)
+}
+
+variable "ipv6_parent" {
+  type = string
+}
+
+variable "strategy" {
+  type = string
+}
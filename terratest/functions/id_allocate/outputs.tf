@@ -0,0 +1,7 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+output "ids" {
+  value = { for name, allocation in provider::iactools::id_allocate(var.pool_ranges, var.requests, var.reserved, var.existing) : name => allocation.id }
+}
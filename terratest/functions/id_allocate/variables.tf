@@ -0,0 +1,19 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+variable "pool_ranges" {
+  type = list(string)
+}
+
+variable "requests" {
+  type = map(number)
+}
+
+variable "reserved" {
+  type = list(string)
+}
+
+variable "existing" {
+  type = map(number)
+}
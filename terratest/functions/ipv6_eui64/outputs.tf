@@ -0,0 +1,11 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+output "ip_address" {
+  value = provider::iactools::ipv6_eui64(var.prefix, var.mac_address)
+}
+
+output "mac_address" {
+  value = provider::iactools::ipv6_eui64_to_mac(provider::iactools::ipv6_eui64(var.prefix, var.mac_address))
+}
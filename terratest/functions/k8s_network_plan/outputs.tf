@@ -0,0 +1,11 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+output "node_subnet_cidr" {
+  value = provider::iactools::k8s_network_plan(var.inputs).node_subnet_cidr
+}
+
+output "dns_service_ip" {
+  value = provider::iactools::k8s_network_plan(var.inputs).dns_service_ip
+}
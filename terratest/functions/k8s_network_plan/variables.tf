@@ -0,0 +1,13 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+variable "inputs" {
+  type = object({
+    network_plugin = string
+    node_count     = number
+    max_pods       = number
+    parent_cidr    = string
+    service_cidr   = string
+  })
+}
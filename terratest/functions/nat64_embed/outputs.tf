@@ -0,0 +1,12 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+
+output "ipv6_address" {
+  value = provider::iactools::nat64_embed(var.ipv4_address, var.prefix)
+}
+
+output "ipv4_address" {
+  value = provider::iactools::nat64_extract(provider::iactools::nat64_embed(var.ipv4_address, var.prefix), var.prefix_length)
+}
@@ -0,0 +1,16 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+
+variable "ipv4_address" {
+  type = string
+}
+
+variable "prefix" {
+  type = string
+}
+
+variable "prefix_length" {
+  type = number
+}
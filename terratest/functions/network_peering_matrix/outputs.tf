@@ -0,0 +1,7 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+output "pairs" {
+  value = [for pair in provider::iactools::network_peering_matrix(var.networks, var.topology) : "${pair.local}:${pair.remote}:${pair.type}"]
+}
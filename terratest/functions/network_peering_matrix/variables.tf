@@ -0,0 +1,14 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+variable "networks" {
+  type = map(object({
+    role   = string
+    region = string
+  }))
+}
+
+variable "topology" {
+  type = string
+}
@@ -0,0 +1,20 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+
+locals {
+  resource_name = provider::iactools::resource_name(var.resource_type, var.components, null)
+}
+
+output "name" {
+  value = local.resource_name.name
+}
+
+output "scope" {
+  value = local.resource_name.scope
+}
+
+output "truncated" {
+  value = local.resource_name.truncated
+}
@@ -0,0 +1,16 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+
+locals {
+  validation = provider::iactools::resource_name_validate(var.resource_type, var.name)
+}
+
+output "valid" {
+  value = local.validation.valid
+}
+
+output "problems" {
+  value = local.validation.problems
+}
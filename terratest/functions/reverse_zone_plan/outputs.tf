@@ -0,0 +1,7 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+output "reverse_zones" {
+  value = [for zone in provider::iactools::reverse_zone_plan(var.cidrs) : zone.zone]
+}
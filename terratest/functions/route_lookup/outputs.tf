@@ -0,0 +1,7 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+output "next_hop_type" {
+  value = provider::iactools::route_lookup(var.routes, var.destination, "azure").next_hop_type
+}
@@ -0,0 +1,15 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+variable "routes" {
+  type = list(object({
+    prefix        = string
+    next_hop_type = string
+    source        = string
+  }))
+}
+
+variable "destination" {
+  type = string
+}
@@ -0,0 +1,7 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+output "priorities" {
+  value = provider::iactools::rule_priorities([{ rules = var.rules }], { existing = var.existing })
+}
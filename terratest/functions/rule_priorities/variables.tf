@@ -0,0 +1,12 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+variable "rules" {
+  type = list(string)
+}
+
+variable "existing" {
+  type    = map(number)
+  default = {}
+}
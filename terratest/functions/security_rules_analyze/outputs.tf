@@ -0,0 +1,7 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+output "findings" {
+  value = [for finding in provider::iactools::security_rules_analyze(var.rules) : "${finding.type}:${finding.rule}:${finding.related_rule}"]
+}
@@ -0,0 +1,15 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+variable "rules" {
+  type = list(object({
+    name                 = string
+    priority             = number
+    action               = string
+    protocol             = string
+    source_prefixes      = list(string)
+    destination_prefixes = list(string)
+    destination_ports    = list(string)
+  }))
+}
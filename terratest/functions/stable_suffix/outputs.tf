@@ -0,0 +1,12 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+
+output "suffix" {
+  value = provider::iactools::stable_suffix(var.inputs, var.length, var.alphabet)
+}
+
+output "short_name" {
+  value = provider::iactools::name_shorten(var.name, var.max_length)
+}
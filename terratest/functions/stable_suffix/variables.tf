@@ -0,0 +1,25 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+
+variable "inputs" {
+  type = list(string)
+}
+
+variable "length" {
+  type = number
+}
+
+variable "alphabet" {
+  type    = string
+  default = null
+}
+
+variable "name" {
+  type = string
+}
+
+variable "max_length" {
+  type = number
+}
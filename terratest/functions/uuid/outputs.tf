@@ -0,0 +1,29 @@
+# Copyright (c) LederWorks
+# SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+# SPDX-License-Identifier: MPL-2.0
+
+
+locals {
+  uuid_v5 = provider::iactools::uuid_v5(var.namespace, var.name)
+  uuid_v7 = provider::iactools::uuid_v7(var.timestamp, var.name)
+}
+
+output "uuid_v3" {
+  value = provider::iactools::uuid_v3(var.namespace, var.name)
+}
+
+output "uuid_v5" {
+  value = local.uuid_v5
+}
+
+output "uuid_v5_version" {
+  value = provider::iactools::uuid_parse(local.uuid_v5).version
+}
+
+output "uuid_v7" {
+  value = local.uuid_v7
+}
+
+output "uuid_v7_timestamp" {
+  value = provider::iactools::uuid_parse(local.uuid_v7).timestamp
+}
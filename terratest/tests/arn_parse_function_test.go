@@ -0,0 +1,52 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package acceptance_test
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestARNParseFunction(t *testing.T) {
+	testCases := map[string]struct {
+		arn          string
+		service      string
+		resourceType string
+		resourceID   string
+	}{
+		"ec2-instance": {
+			arn:          "arn:aws:ec2:eu-west-1:123456789012:instance/i-0abc123",
+			service:      "ec2",
+			resourceType: "instance",
+			resourceID:   "i-0abc123",
+		},
+		"iam-role-path": {
+			arn:          "arn:aws:iam::123456789012:role/service-role/ci/deployer",
+			service:      "iam",
+			resourceType: "role",
+			resourceID:   "deployer",
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+				TerraformDir: "../functions/arn_parse",
+				Vars: map[string]interface{}{
+					"arn": testCase.arn,
+				},
+			})
+
+			defer terraform.Destroy(t, terraformOptions)
+			terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+
+			assert.Equal(t, testCase.service, terraform.Output(t, terraformOptions, "service"), "service")
+			assert.Equal(t, testCase.resourceType, terraform.Output(t, terraformOptions, "resource_type"), "resource_type")
+			assert.Equal(t, testCase.resourceID, terraform.Output(t, terraformOptions, "resource_id"), "resource_id")
+			assert.Equal(t, testCase.arn, terraform.Output(t, terraformOptions, "rebuilt_arn"), "rebuilt_arn")
+		})
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package acceptance_test
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestASNParseFunction(t *testing.T) {
+	testCases := map[string]struct {
+		value     string
+		asn       string
+		asdot     string
+		isPrivate string
+	}{
+		"asdot": {
+			value:     "64086.59904",
+			asn:       "4200000000",
+			asdot:     "64086.59904",
+			isPrivate: "true",
+		},
+		"asplain": {
+			value:     "AS65546",
+			asn:       "65546",
+			asdot:     "1.10",
+			isPrivate: "false",
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+				TerraformDir: "../functions/asn_parse",
+				Vars: map[string]interface{}{
+					"value": testCase.value,
+				},
+			})
+
+			defer terraform.Destroy(t, terraformOptions)
+			terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+
+			assert.Equal(t, testCase.asn, terraform.Output(t, terraformOptions, "asn"), "asn")
+			assert.Equal(t, testCase.asdot, terraform.Output(t, terraformOptions, "asdot"), "asdot")
+			assert.Equal(t, testCase.isPrivate, terraform.Output(t, terraformOptions, "is_private"), "is_private")
+		})
+	}
+}
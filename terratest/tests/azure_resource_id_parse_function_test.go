@@ -0,0 +1,56 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package acceptance_test
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAzureResourceIDParseFunction(t *testing.T) {
+	testCases := map[string]struct {
+		id           string
+		resourceType string
+		names        []string
+		parentID     string
+		scopeLevel   string
+	}{
+		"subnet": {
+			id:           "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-network/providers/Microsoft.Network/virtualNetworks/vnet-hub/subnets/snet-app",
+			resourceType: "Microsoft.Network/virtualNetworks/subnets",
+			names:        []string{"vnet-hub", "snet-app"},
+			parentID:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-network/providers/Microsoft.Network/virtualNetworks/vnet-hub",
+			scopeLevel:   "resource_group",
+		},
+		"management-group-policy-assignment": {
+			id:           "/providers/Microsoft.Management/managementGroups/mg-platform/providers/Microsoft.Authorization/policyAssignments/deny-public-ip",
+			resourceType: "Microsoft.Authorization/policyAssignments",
+			names:        []string{"deny-public-ip"},
+			parentID:     "/providers/Microsoft.Management/managementGroups/mg-platform",
+			scopeLevel:   "management_group",
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+				TerraformDir: "../functions/azure_resource_id_parse",
+				Vars: map[string]interface{}{
+					"id": testCase.id,
+				},
+			})
+
+			defer terraform.Destroy(t, terraformOptions)
+			terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+
+			assert.Equal(t, testCase.resourceType, terraform.Output(t, terraformOptions, "resource_type"), "resource_type")
+			assert.Equal(t, testCase.names, terraform.OutputList(t, terraformOptions, "names"), "names")
+			assert.Equal(t, testCase.parentID, terraform.Output(t, terraformOptions, "parent_id"), "parent_id")
+			assert.Equal(t, testCase.scopeLevel, terraform.Output(t, terraformOptions, "scope_level"), "scope_level")
+			assert.Equal(t, testCase.id, terraform.Output(t, terraformOptions, "rebuilt_id"), "rebuilt_id")
+		})
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package acceptance_test
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCIDRClassifyFunction(t *testing.T) {
+	testCases := map[string]struct {
+		cidr    string
+		matches []string
+	}{
+		"docker-bridge": {
+			cidr:    "172.17.0.0/16",
+			matches: []string{"iana 172.16.0.0/12 within", "docker 172.17.0.0/16 equal"},
+		},
+		"global": {
+			cidr:    "20.0.0.0/16",
+			matches: []string{},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+				TerraformDir: "../functions/cidr_classify",
+				Vars: map[string]interface{}{
+					"cidr": testCase.cidr,
+				},
+			})
+
+			defer terraform.Destroy(t, terraformOptions)
+			terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+
+			assert.Equal(t, testCase.matches, terraform.OutputList(t, terraformOptions, "matches"), "matches")
+		})
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package acceptance_test
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCIDRUtilizationFunction(t *testing.T) {
+	testCases := map[string]struct {
+		parent        string
+		allocated     []string
+		utilization   string
+		freeBlocks    []string
+		fragmentation string
+	}{
+		"half-used": {
+			parent:        "10.0.0.0/24",
+			allocated:     []string{"10.0.0.0/26", "10.0.0.128/26"},
+			utilization:   "50",
+			freeBlocks:    []string{"10.0.0.64/26", "10.0.0.192/26"},
+			fragmentation: "0.5",
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+				TerraformDir: "../functions/cidr_utilization",
+				Vars: map[string]interface{}{
+					"parent":    testCase.parent,
+					"allocated": testCase.allocated,
+				},
+			})
+
+			defer terraform.Destroy(t, terraformOptions)
+			terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+
+			assert.Equal(t, testCase.utilization, terraform.Output(t, terraformOptions, "utilization"), "utilization")
+			assert.Equal(t, testCase.freeBlocks, terraform.OutputList(t, terraformOptions, "free_blocks"), "free_blocks")
+			assert.Equal(t, testCase.fragmentation, terraform.Output(t, terraformOptions, "fragmentation"), "fragmentation")
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package acceptance_test
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCloudIPRangesFunction(t *testing.T) {
+	testCases := map[string]struct {
+		region    string
+		summarize bool
+		prefixes  []string
+	}{
+		"eu-west-1": {
+			region:    "eu-west-1",
+			summarize: false,
+			prefixes:  []string{"52.94.72.0/22", "52.94.76.0/22", "2a05:d07a:a000::/40"},
+		},
+		"eu-west-1-summarized": {
+			region:    "eu-west-1",
+			summarize: true,
+			prefixes:  []string{"52.94.72.0/21", "2a05:d07a:a000::/40"},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+				TerraformDir: "../functions/cloud_ip_ranges",
+				Vars: map[string]interface{}{
+					"region":    testCase.region,
+					"summarize": testCase.summarize,
+				},
+			})
+
+			defer terraform.Destroy(t, terraformOptions)
+			terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+
+			assert.Equal(t, testCase.prefixes, terraform.OutputList(t, terraformOptions, "prefixes"), "prefixes")
+		})
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package acceptance_test
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDMARCBuildFunction(t *testing.T) {
+	testCases := map[string]struct {
+		policy map[string]string
+		record string
+	}{
+		"reject": {
+			policy: map[string]string{"p": "reject"},
+			record: "v=DMARC1; p=reject",
+		},
+		"reporting": {
+			policy: map[string]string{"p": "quarantine", "pct": "25", "rua": "mailto:dmarc@example.com"},
+			record: "v=DMARC1; p=quarantine; pct=25; rua=mailto:dmarc@example.com",
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+				TerraformDir: "../functions/dmarc_build",
+				Vars: map[string]interface{}{
+					"policy": testCase.policy,
+				},
+			})
+
+			defer terraform.Destroy(t, terraformOptions)
+			terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+
+			assert.Equal(t, testCase.record, terraform.Output(t, terraformOptions, "dmarc_build"), "dmarc_build")
+		})
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package acceptance_test
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDNSSECDSFunction(t *testing.T) {
+	testCases := map[string]struct {
+		owner       string
+		dnskeyRdata string
+		digestType  int
+		record      string
+	}{
+		"rfc4509-sha256": {
+			owner:       "dskey.example.com",
+			dnskeyRdata: "256 3 5 AQOeiiR0GOMYkDshWoSKz9XzfwJr1AYtsmx3TGkJaNXVbfi/2pHm822aJ5iI9BMzNXxeYCmZDRD99WYwYqUSdjMmmAphXdvxegXd/M5+X7OrzKBaMbCVdFLUUh6DhweJBjEVv5f2wwjM9XzcnOf+EPbtG9DMBmADjFDc2w/rljwvFw==",
+			digestType:  2,
+			record:      "60485 5 2 D4B7D520E7BB5F0F67674A0CCEB1E3E0614B93C4F9E99B8383F6A1E4469DA50A",
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+				TerraformDir: "../functions/dnssec_ds",
+				Vars: map[string]interface{}{
+					"owner":        testCase.owner,
+					"dnskey_rdata": testCase.dnskeyRdata,
+					"digest_type":  testCase.digestType,
+				},
+			})
+
+			defer terraform.Destroy(t, terraformOptions)
+			terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+
+			assert.Equal(t, testCase.record, terraform.Output(t, terraformOptions, "dnssec_ds"), "dnssec_ds")
+		})
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package acceptance_test
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDualStackMapFunction(t *testing.T) {
+	testCases := map[string]struct {
+		ipv4Subnets []string
+		ipv6Parent  string
+		strategy    string
+		ipv6Subnets map[string]string
+	}{
+		"index": {
+			ipv4Subnets: []string{"10.1.0.0/24", "10.1.1.0/24"},
+			ipv6Parent:  "2001:db8::/48",
+			strategy:    "index",
+			ipv6Subnets: map[string]string{"10.1.0.0/24": "2001:db8::/64", "10.1.1.0/24": "2001:db8:0:1::/64"},
+		},
+		"embed": {
+			ipv4Subnets: []string{"10.1.5.0/24", "10.1.200.0/24"},
+			ipv6Parent:  "2001:db8::/48",
+			strategy:    "embed",
+			ipv6Subnets: map[string]string{"10.1.5.0/24": "2001:db8:0:5::/64", "10.1.200.0/24": "2001:db8:0:c8::/64"},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+				TerraformDir: "../functions/dualstack_map",
+				Vars: map[string]interface{}{
+					"ipv4_subnets": testCase.ipv4Subnets,
+					"ipv6_parent":  testCase.ipv6Parent,
+					"strategy":     testCase.strategy,
+				},
+			})
+
+			defer terraform.Destroy(t, terraformOptions)
+			terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+
+			assert.Equal(t, testCase.ipv6Subnets, terraform.OutputMap(t, terraformOptions, "ipv6_subnets"), "ipv6_subnets")
+		})
+	}
+}
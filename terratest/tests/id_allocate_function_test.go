@@ -0,0 +1,55 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package acceptance_test
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIDAllocateFunction(t *testing.T) {
+	testCases := map[string]struct {
+		poolRanges []string
+		requests   map[string]int
+		reserved   []string
+		existing   map[string]int
+		ids        map[string]string
+	}{
+		"vlans": {
+			poolRanges: []string{"1000-1010"},
+			requests:   map[string]int{"a": 4, "b": 2},
+			reserved:   []string{"1002-1005"},
+			existing:   map[string]int{},
+			ids:        map[string]string{"a": "1006", "b": "1000"},
+		},
+		"existing": {
+			poolRanges: []string{"1-20"},
+			requests:   map[string]int{"aaa": 1, "app": 1, "web": 1},
+			reserved:   []string{"1"},
+			existing:   map[string]int{"app": 2, "web": 3},
+			ids:        map[string]string{"aaa": "4", "app": "2", "web": "3"},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+				TerraformDir: "../functions/id_allocate",
+				Vars: map[string]interface{}{
+					"pool_ranges": testCase.poolRanges,
+					"requests":    testCase.requests,
+					"reserved":    testCase.reserved,
+					"existing":    testCase.existing,
+				},
+			})
+
+			defer terraform.Destroy(t, terraformOptions)
+			terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+
+			assert.Equal(t, testCase.ids, terraform.OutputMap(t, terraformOptions, "ids"), "ids")
+		})
+	}
+}
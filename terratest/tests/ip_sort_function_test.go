@@ -0,0 +1,39 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package acceptance_test
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIPSortFunction(t *testing.T) {
+	testCases := map[string]struct {
+		values []string
+		sorted []string
+	}{
+		"mixed": {
+			values: []string{"10.0.0.10", "2001:db8::1", "10.0.0.9", "10.0.0.0/24"},
+			sorted: []string{"10.0.0.0/24", "10.0.0.9", "10.0.0.10", "2001:db8::1"},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+				TerraformDir: "../functions/ip_sort",
+				Vars: map[string]interface{}{
+					"values": testCase.values,
+				},
+			})
+
+			defer terraform.Destroy(t, terraformOptions)
+			terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+
+			assert.Equal(t, testCase.sorted, terraform.OutputList(t, terraformOptions, "sorted"), "sorted")
+		})
+	}
+}
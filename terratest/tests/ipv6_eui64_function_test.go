@@ -0,0 +1,45 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package acceptance_test
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIPv6EUI64Function(t *testing.T) {
+	testCases := map[string]struct {
+		prefix     string
+		macAddress string
+		ipAddress  string
+		normalized string
+	}{
+		"dash": {
+			prefix:     "2001:db8:1:2::/64",
+			macAddress: "00-0D-3A-12-34-56",
+			ipAddress:  "2001:db8:1:2:20d:3aff:fe12:3456",
+			normalized: "00:0d:3a:12:34:56",
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+				TerraformDir: "../functions/ipv6_eui64",
+				Vars: map[string]interface{}{
+					"prefix":      testCase.prefix,
+					"mac_address": testCase.macAddress,
+				},
+			})
+
+			defer terraform.Destroy(t, terraformOptions)
+			terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+
+			assert.Equal(t, testCase.ipAddress, terraform.Output(t, terraformOptions, "ip_address"), "ip_address")
+			assert.Equal(t, testCase.normalized, terraform.Output(t, terraformOptions, "mac_address"), "mac_address")
+		})
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package acceptance_test
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestK8sNetworkPlanFunction(t *testing.T) {
+	testCases := map[string]struct {
+		inputs         map[string]interface{}
+		nodeSubnetCIDR string
+		dnsServiceIP   string
+	}{
+		"vnet": {
+			inputs: map[string]interface{}{
+				"network_plugin": "vnet",
+				"node_count":     9,
+				"max_pods":       30,
+				"parent_cidr":    "10.1.0.0/16",
+				"service_cidr":   "172.16.0.0/16",
+			},
+			nodeSubnetCIDR: "10.1.0.0/23",
+			dnsServiceIP:   "172.16.0.10",
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+				TerraformDir: "../functions/k8s_network_plan",
+				Vars: map[string]interface{}{
+					"inputs": testCase.inputs,
+				},
+			})
+
+			defer terraform.Destroy(t, terraformOptions)
+			terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+
+			assert.Equal(t, testCase.nodeSubnetCIDR, terraform.Output(t, terraformOptions, "node_subnet_cidr"), "node_subnet_cidr")
+			assert.Equal(t, testCase.dnsServiceIP, terraform.Output(t, terraformOptions, "dns_service_ip"), "dns_service_ip")
+		})
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package acceptance_test
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNAT64EmbedFunction(t *testing.T) {
+	testCases := map[string]struct {
+		ipv4Address  string
+		prefix       string
+		prefixLength int
+		ipv6Address  string
+	}{
+		"well-known-prefix": {
+			ipv4Address:  "8.8.8.8",
+			prefix:       "64:ff9b::/96",
+			prefixLength: 96,
+			ipv6Address:  "64:ff9b::808:808",
+		},
+		"prefix-48": {
+			ipv4Address:  "192.0.2.33",
+			prefix:       "2001:db8:122::/48",
+			prefixLength: 48,
+			ipv6Address:  "2001:db8:122:c000:2:2100::",
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+				TerraformDir: "../functions/nat64_embed",
+				Vars: map[string]interface{}{
+					"ipv4_address":  testCase.ipv4Address,
+					"prefix":        testCase.prefix,
+					"prefix_length": testCase.prefixLength,
+				},
+			})
+
+			defer terraform.Destroy(t, terraformOptions)
+			terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+
+			assert.Equal(t, testCase.ipv6Address, terraform.Output(t, terraformOptions, "ipv6_address"), "ipv6_address")
+			assert.Equal(t, testCase.ipv4Address, terraform.Output(t, terraformOptions, "ipv4_address"), "ipv4_address")
+		})
+	}
+}
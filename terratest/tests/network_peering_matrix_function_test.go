@@ -0,0 +1,47 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package acceptance_test
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetworkPeeringMatrixFunction(t *testing.T) {
+	testCases := map[string]struct {
+		networks map[string]interface{}
+		topology string
+		pairs    []string
+	}{
+		"multi-hub": {
+			networks: map[string]interface{}{
+				"hub-weu": map[string]string{"role": "hub", "region": "westeurope"},
+				"hub-neu": map[string]string{"role": "hub", "region": "northeurope"},
+				"app-weu": map[string]string{"role": "spoke", "region": "westeurope"},
+				"app-neu": map[string]string{"role": "spoke", "region": "northeurope"},
+			},
+			topology: "multi_hub",
+			pairs:    []string{"hub-neu:app-neu:hub_spoke", "hub-neu:hub-weu:hub_hub", "hub-weu:app-weu:hub_spoke"},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+				TerraformDir: "../functions/network_peering_matrix",
+				Vars: map[string]interface{}{
+					"networks": testCase.networks,
+					"topology": testCase.topology,
+				},
+			})
+
+			defer terraform.Destroy(t, terraformOptions)
+			terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+
+			assert.Equal(t, testCase.pairs, terraform.OutputList(t, terraformOptions, "pairs"), "pairs")
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package acceptance_test
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPortsSubtractFunction(t *testing.T) {
+	testCases := map[string]struct {
+		ports  string
+		remove string
+		result []string
+	}{
+		"everything-except": {
+			ports:  "*",
+			remove: "22,3389",
+			result: []string{"0-21", "23-3388", "3390-65535"},
+		},
+		"with-protocols": {
+			ports:  "tcp/8000-8100,udp/53",
+			remove: "tcp/8050",
+			result: []string{"tcp/8000-8049", "tcp/8051-8100", "udp/53"},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+				TerraformDir: "../functions/ports_subtract",
+				Vars: map[string]interface{}{
+					"ports":  testCase.ports,
+					"remove": testCase.remove,
+				},
+			})
+
+			defer terraform.Destroy(t, terraformOptions)
+			terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+
+			assert.Equal(t, testCase.result, terraform.OutputList(t, terraformOptions, "ports"), "ports")
+		})
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package acceptance_test
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceNameFunction(t *testing.T) {
+	testCases := map[string]struct {
+		resourceType string
+		components   map[string]string
+		name         string
+		scope        string
+		truncated    string
+	}{
+		"storage-account": {
+			resourceType: "azurerm_storage_account",
+			components:   map[string]string{"workload": "payments-api", "environment": "prod", "region": "weu", "instance": "001"},
+			name:         "stpaymentsapiprodweu001",
+			scope:        "global",
+			truncated:    "false",
+		},
+		"key-vault-truncated": {
+			resourceType: "azurerm_key_vault",
+			components:   map[string]string{"workload": "customer-payments", "environment": "production", "region": "westeurope"},
+			name:         "kv-cust-produ-weste-2079",
+			scope:        "global",
+			truncated:    "true",
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+				TerraformDir: "../functions/resource_name",
+				Vars: map[string]interface{}{
+					"resource_type": testCase.resourceType,
+					"components":    testCase.components,
+				},
+			})
+
+			defer terraform.Destroy(t, terraformOptions)
+			terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+
+			assert.Equal(t, testCase.name, terraform.Output(t, terraformOptions, "name"), "name")
+			assert.Equal(t, testCase.scope, terraform.Output(t, terraformOptions, "scope"), "scope")
+			assert.Equal(t, testCase.truncated, terraform.Output(t, terraformOptions, "truncated"), "truncated")
+		})
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package acceptance_test
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceNameValidateFunction(t *testing.T) {
+	testCases := map[string]struct {
+		resourceType string
+		name         string
+		valid        string
+		problems     []string
+	}{
+		"valid": {
+			resourceType: "azurerm_key_vault",
+			name:         "kv-payments-prod-weu-001",
+			valid:        "true",
+			problems:     []string{},
+		},
+		"reserved-prefix": {
+			resourceType: "azurerm_linux_web_app",
+			name:         "login-portal",
+			valid:        "false",
+			problems:     []string{`the name must not start with the reserved prefix "login"`},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+				TerraformDir: "../functions/resource_name_validate",
+				Vars: map[string]interface{}{
+					"resource_type": testCase.resourceType,
+					"name":          testCase.name,
+				},
+			})
+
+			defer terraform.Destroy(t, terraformOptions)
+			terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+
+			assert.Equal(t, testCase.valid, terraform.Output(t, terraformOptions, "valid"), "valid")
+			assert.Equal(t, testCase.problems, terraform.OutputList(t, terraformOptions, "problems"), "problems")
+		})
+	}
+}
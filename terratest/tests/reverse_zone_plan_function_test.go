@@ -0,0 +1,39 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package acceptance_test
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReverseZonePlanFunction(t *testing.T) {
+	testCases := map[string]struct {
+		cidrs        []string
+		reverseZones []string
+	}{
+		"ipv4-mixed": {
+			cidrs:        []string{"10.20.0.0/23", "10.20.32.0/26"},
+			reverseZones: []string{"0.20.10.in-addr.arpa.", "1.20.10.in-addr.arpa.", "0/26.32.20.10.in-addr.arpa."},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+				TerraformDir: "../functions/reverse_zone_plan",
+				Vars: map[string]interface{}{
+					"cidrs": testCase.cidrs,
+				},
+			})
+
+			defer terraform.Destroy(t, terraformOptions)
+			terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+
+			assert.Equal(t, testCase.reverseZones, terraform.OutputList(t, terraformOptions, "reverse_zones"), "reverse_zones")
+		})
+	}
+}
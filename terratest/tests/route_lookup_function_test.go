@@ -0,0 +1,49 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package acceptance_test
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRouteLookupFunction(t *testing.T) {
+	routes := []map[string]interface{}{
+		{"prefix": "10.1.0.0/16", "next_hop_type": "VnetLocal", "source": "system"},
+		{"prefix": "0.0.0.0/0", "next_hop_type": "Internet", "source": "system"},
+		{"prefix": "0.0.0.0/0", "next_hop_type": "VirtualAppliance", "source": "user"},
+	}
+	testCases := map[string]struct {
+		destination string
+		nextHopType string
+	}{
+		"forced-tunnelling": {
+			destination: "8.8.8.8",
+			nextHopType: "VirtualAppliance",
+		},
+		"virtual-network": {
+			destination: "10.1.2.3",
+			nextHopType: "VnetLocal",
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+				TerraformDir: "../functions/route_lookup",
+				Vars: map[string]interface{}{
+					"routes":      routes,
+					"destination": testCase.destination,
+				},
+			})
+
+			defer terraform.Destroy(t, terraformOptions)
+			terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+
+			assert.Equal(t, testCase.nextHopType, terraform.Output(t, terraformOptions, "next_hop_type"), "next_hop_type")
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package acceptance_test
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRulePrioritiesFunction(t *testing.T) {
+	testCases := map[string]struct {
+		rules      []string
+		existing   map[string]int
+		priorities map[string]string
+	}{
+		"new-rule-set": {
+			rules:      []string{"allow-bastion", "allow-load-balancer"},
+			existing:   map[string]int{},
+			priorities: map[string]string{"allow-bastion": "100", "allow-load-balancer": "110"},
+		},
+		"insert-in-the-middle": {
+			rules:      []string{"allow-bastion", "allow-monitoring", "allow-load-balancer"},
+			existing:   map[string]int{"allow-bastion": 100, "allow-load-balancer": 110},
+			priorities: map[string]string{"allow-bastion": "100", "allow-monitoring": "105", "allow-load-balancer": "110"},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+				TerraformDir: "../functions/rule_priorities",
+				Vars: map[string]interface{}{
+					"rules":    testCase.rules,
+					"existing": testCase.existing,
+				},
+			})
+
+			defer terraform.Destroy(t, terraformOptions)
+			terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+
+			assert.Equal(t, testCase.priorities, terraform.OutputMap(t, terraformOptions, "priorities"), "priorities")
+		})
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package acceptance_test
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecurityRulesAnalyzeFunction(t *testing.T) {
+	testCases := map[string]struct {
+		rules    []map[string]interface{}
+		findings []string
+	}{
+		"shadowed-and-conflict": {
+			rules: []map[string]interface{}{
+				{"name": "deny-legacy", "priority": 100, "action": "Deny", "protocol": "Tcp", "source_prefixes": []string{"10.1.0.0/16"}, "destination_prefixes": []string{"*"}, "destination_ports": []string{"8000-8100"}},
+				{"name": "allow-web", "priority": 110, "action": "Allow", "protocol": "Tcp", "source_prefixes": []string{"10.0.0.0/8"}, "destination_prefixes": []string{"*"}, "destination_ports": []string{"80", "443", "8080"}},
+				{"name": "allow-proxy", "priority": 120, "action": "Allow", "protocol": "Tcp", "source_prefixes": []string{"10.1.2.0/24"}, "destination_prefixes": []string{"*"}, "destination_ports": []string{"8080"}},
+			},
+			findings: []string{"conflict:allow-web:deny-legacy", "shadowed:allow-proxy:deny-legacy"},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+				TerraformDir: "../functions/security_rules_analyze",
+				Vars: map[string]interface{}{
+					"rules": testCase.rules,
+				},
+			})
+
+			defer terraform.Destroy(t, terraformOptions)
+			terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+
+			assert.Equal(t, testCase.findings, terraform.OutputList(t, terraformOptions, "findings"), "findings")
+		})
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package acceptance_test
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStableSuffixFunction(t *testing.T) {
+	testCases := map[string]struct {
+		inputs    []string
+		length    int
+		alphabet  string
+		name      string
+		maxLength int
+		suffix    string
+		shortName string
+	}{
+		"base36": {
+			inputs:    []string{"sub-123", "prod", "payments"},
+			length:    6,
+			alphabet:  "base36",
+			name:      "payments-processing-service-production",
+			maxLength: 24,
+			suffix:    "l3j10b",
+			shortName: "payments-processi-l3kg1n",
+		},
+		"hex": {
+			inputs:    []string{"a"},
+			length:    8,
+			alphabet:  "hex",
+			name:      "payments",
+			maxLength: 24,
+			suffix:    "ca978112",
+			shortName: "payments",
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+				TerraformDir: "../functions/stable_suffix",
+				Vars: map[string]interface{}{
+					"inputs":     testCase.inputs,
+					"length":     testCase.length,
+					"alphabet":   testCase.alphabet,
+					"name":       testCase.name,
+					"max_length": testCase.maxLength,
+				},
+			})
+
+			defer terraform.Destroy(t, terraformOptions)
+			terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+
+			assert.Equal(t, testCase.suffix, terraform.Output(t, terraformOptions, "suffix"), "suffix")
+			assert.Equal(t, testCase.shortName, terraform.Output(t, terraformOptions, "short_name"), "short_name")
+		})
+	}
+}
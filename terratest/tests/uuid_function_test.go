@@ -0,0 +1,55 @@
+// Copyright (c) LederWorks
+// SPDX-FileCopyrightText: The terraform-provider-iactools Authors
+// SPDX-License-Identifier: MPL-2.0
+
+package acceptance_test
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/terratest/modules/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUUIDFunction(t *testing.T) {
+	testCases := map[string]struct {
+		namespace       string
+		name            string
+		timestamp       string
+		uuidV3          string
+		uuidV5          string
+		uuidV7          string
+		uuidV7Timestamp string
+	}{
+		"dns-namespace": {
+			namespace:       "dns",
+			name:            "www.example.com",
+			timestamp:       "2022-02-22T19:22:22Z",
+			uuidV3:          "5df41881-3aed-3515-88a7-2f4a814cf09e",
+			uuidV5:          "2ed6657d-e927-568b-95e1-2665a8aea6a2",
+			uuidV7:          "017f22e2-79b0-70fc-8fb9-266db7b83f85",
+			uuidV7Timestamp: "2022-02-22T19:22:22Z",
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			terraformOptions := terraform.WithDefaultRetryableErrors(t, &terraform.Options{
+				TerraformDir: "../functions/uuid",
+				Vars: map[string]interface{}{
+					"namespace": testCase.namespace,
+					"name":      testCase.name,
+					"timestamp": testCase.timestamp,
+				},
+			})
+
+			defer terraform.Destroy(t, terraformOptions)
+			terraform.InitAndApplyAndIdempotent(t, terraformOptions)
+
+			assert.Equal(t, testCase.uuidV3, terraform.Output(t, terraformOptions, "uuid_v3"), "uuid_v3")
+			assert.Equal(t, testCase.uuidV5, terraform.Output(t, terraformOptions, "uuid_v5"), "uuid_v5")
+			assert.Equal(t, "5", terraform.Output(t, terraformOptions, "uuid_v5_version"), "uuid_v5_version")
+			assert.Equal(t, testCase.uuidV7, terraform.Output(t, terraformOptions, "uuid_v7"), "uuid_v7")
+			assert.Equal(t, testCase.uuidV7Timestamp, terraform.Output(t, terraformOptions, "uuid_v7_timestamp"), "uuid_v7_timestamp")
+		})
+	}
+}